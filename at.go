@@ -0,0 +1,68 @@
+package table
+
+import (
+	"fmt"
+)
+
+// At returns the value with the given key, like GetPath with a single key,
+// for chaining calls, e.g. t.At("a").At(0).At("b").Int().
+//
+// Instead of returning an error, At returns a Table carrying the error,
+// and then At on it returns itself, so the first error is carried through.
+// Every getter of the Table returns the carried error, as an ErrPath with
+// the path where it happened. At on the nil *Table returns a Table whose
+// error is a missing value, like At of a key not found.
+func (t *Table) At(k interface{}) *Table {
+	if t != nil && t.err != nil {
+		return t
+	}
+
+	var p Path
+	if t != nil {
+		p = t.path
+	}
+	p = p.append(k)
+
+	v, err := t.GetPath(Path{k})
+	switch {
+	case err != nil:
+		return &Table{path: p, err: &ErrPath{p, err}}
+	case v == nil:
+		return &Table{path: p, err: &ErrPath{p, &ErrNotExist{"Table.At", fmt.Sprint(k) + " key"}}}
+	default:
+		v.path = p
+		return v
+	}
+}
+
+// Err returns the error t carries, see At.
+// It returns ErrNotExist if t is the nil *Table.
+func (t *Table) Err() error {
+	return t.check("Table.Err")
+}
+
+// Path returns the path t is got at by Get, GetPath or At.
+func (t *Table) Path() Path {
+	if t == nil {
+		return nil
+	}
+	return t.path
+}
+
+// mustErr returns err wrapped as an ErrPath at t's path,
+// the panic value of the Must* api.
+func (t *Table) mustErr(err error) error {
+	if _, ok := err.(*ErrPath); ok {
+		return err
+	}
+	return &ErrPath{t.Path(), err}
+}
+
+// check returns the error t carries,
+// or ErrNotExist if t is the nil *Table.
+func (t *Table) check(method string) error {
+	if t == nil {
+		return &ErrNotExist{method, "value"}
+	}
+	return t.err
+}
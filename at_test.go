@@ -0,0 +1,53 @@
+package table
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("At", func() {
+	x := map[string]interface{}{
+		"a": []interface{}{
+			map[string]interface{}{"b": 1},
+		},
+		"s": "str",
+	}
+	t := New(x)
+
+	Specify("chaining found values", func() {
+		v := t.At("a").At(0).At("b")
+		gomega.Expect(v.Int()).Should(gomega.Equal(1))
+		gomega.Expect(v.Err()).Should(gomega.BeNil())
+		gomega.Expect(v.Path()).Should(gomega.Equal(Path{"a", 0, "b"}))
+	})
+	Specify("carrying the first error", func() {
+		v := t.At("a").At(1).At("b")
+		_, err := v.Int()
+		gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrPath)(nil)))
+		gomega.Expect(err.(*ErrPath).Path).Should(gomega.Equal(Path{"a", 1}))
+
+		var ne *ErrNotExist
+		gomega.Expect(errors.As(err, &ne)).Should(gomega.BeTrue())
+		gomega.Expect(v.Exists()).Should(gomega.BeFalse())
+		gomega.Expect(v.Interface()).Should(gomega.BeNil())
+		ExpectErr(v.Map()).Should(gomega.Equal(err))
+	})
+	Specify("into a scalar", func() {
+		_, err := t.At("s").At("x").String()
+		gomega.Expect(err.Error()).Should(gomega.Equal("table: at s.x: call of Table.GetPath on string value"))
+	})
+	Specify("on the nil *Table", func() {
+		var nt *Table
+		gomega.Expect(nt.At("a").Err()).To(gomega.BeAssignableToTypeOf((*ErrPath)(nil)))
+		ExpectErr(nt.Int()).To(gomega.BeAssignableToTypeOf((*ErrNotExist)(nil)))
+		ExpectErr(t.MustGet("missing").String()).To(gomega.BeAssignableToTypeOf((*ErrNotExist)(nil)))
+		gomega.Expect(nt.EachDo(func(k, v *Table) error { return nil })).To(gomega.BeAssignableToTypeOf((*ErrNotExist)(nil)))
+	})
+	Specify("with defaults", func() {
+		gomega.Expect(t.At("a").At(5).IntOr("b", 2)).Should(gomega.Equal(2))
+		gomega.Expect(AsOr(t.At("a").At(0).At("b"), 3)).Should(gomega.Equal(1))
+		gomega.Expect(AsOr(t.At("x").At(0), 3)).Should(gomega.Equal(3))
+	})
+})
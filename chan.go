@@ -0,0 +1,173 @@
+package table
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// EachDoContext is like EachDo, but stops with ctx.Err() once ctx is done.
+//
+// If t's kind is Chan, it waits for ctx and the channel at the same time,
+// so a stalled sender can't block it beyond ctx, and EachItemTimeout limits
+// the time waiting for every item.
+func (t *Table) EachDoContext(ctx context.Context, f eachDoFunc, opts ...EachOption) error {
+	return t.eachContext(ctx, "Table.EachDoContext", newEachOptions(opts), f)
+}
+
+func (t *Table) eachContext(ctx context.Context, method string, o *eachOptions, f eachDoFunc) error {
+	if err := t.check(method); err != nil {
+		return err
+	}
+
+	if cv := indirect(t.getv()); cv.Kind() == reflect.Chan {
+		return recvEach(ctx, method, cv, o, f)
+	}
+
+	var ferr error
+	err := t.each(method, o, func(k, v *Table) bool {
+		if ferr = ctx.Err(); ferr != nil {
+			return false
+		}
+		ferr = f(k, v)
+		return ferr == nil
+	})
+	if err != nil {
+		return err
+	}
+	return ferr
+}
+
+// recvEach receives values of the channel cv until it's closed.
+func recvEach(ctx context.Context, method string, cv reflect.Value, o *eachOptions, f eachDoFunc) error {
+	if cv.Type().ChanDir()&reflect.RecvDir == 0 {
+		return &ErrUnsupportedKind{method, "send-only chan"}
+	}
+
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		{Dir: reflect.SelectRecv, Chan: cv},
+	}
+	for idx := 0; o.maxItems <= 0 || idx < o.maxItems; idx++ {
+		var timer *time.Timer
+		if o.itemTimeout > 0 {
+			timer = time.NewTimer(o.itemTimeout)
+			cases = append(cases[:2], reflect.SelectCase{
+				Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C),
+			})
+		}
+
+		chosen, v, ok := reflect.Select(cases)
+		if timer != nil {
+			timer.Stop()
+		}
+		switch chosen {
+		case 0:
+			return ctx.Err()
+		case 2:
+			return &ErrTimeout{method}
+		}
+		if !ok {
+			return nil
+		}
+
+		if err := f(&Table{i: idx}, &Table{v: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chanv returns t's underlying channel which can be dir,
+// a frozen one can't be sent to or closed.
+func (t *Table) chanv(method string, dir reflect.ChanDir) (reflect.Value, error) {
+	check := t.check
+	if dir == reflect.SendDir {
+		check = t.checkSet
+	}
+	if err := check(method); err != nil {
+		return reflect.Value{}, err
+	}
+
+	cv := indirect(t.getv())
+	if cv.Kind() != reflect.Chan {
+		return cv, &ErrUnsupportedKind{method, cv.Kind()}
+	}
+	if cv.Type().ChanDir()&dir == 0 {
+		return cv, &ErrUnsupportedKind{method, cv.Type().ChanDir().String() + " chan"}
+	}
+	return cv, nil
+}
+
+// chanElem returns v as a value can be sent to the channel cv.
+func chanElem(method string, cv reflect.Value, v interface{}) (reflect.Value, error) {
+	ev, ok := assignable(reflect.ValueOf(v), cv.Type().Elem())
+	if !ok {
+		return ev, &ErrTypeUnequal{method, cv.Type().Elem().Kind(), ev.Kind()}
+	}
+	return ev, nil
+}
+
+// Send sends v to t's underlying channel, blocking until it's sent.
+// Like a send statement, it panics if the channel is closed.
+// It returns error if t's kind is not Chan or v can't be sent to it.
+func (t *Table) Send(v interface{}) error {
+	cv, err := t.chanv("Table.Send", reflect.SendDir)
+	if err != nil {
+		return err
+	}
+	ev, err := chanElem("Table.Send", cv, v)
+	if err != nil {
+		return err
+	}
+	cv.Send(ev)
+	return nil
+}
+
+// TrySend sends v to t's underlying channel without blocking,
+// it reports whether v is sent.
+// It returns error if t's kind is not Chan or v can't be sent to it.
+func (t *Table) TrySend(v interface{}) (bool, error) {
+	cv, err := t.chanv("Table.TrySend", reflect.SendDir)
+	if err != nil {
+		return false, err
+	}
+	ev, err := chanElem("Table.TrySend", cv, v)
+	if err != nil {
+		return false, err
+	}
+	return cv.TrySend(ev), nil
+}
+
+// TryRecv receives a value from t's underlying channel without blocking.
+//
+// It returns the value and true if one is received, or the nil and false
+// if none is ready. It returns ErrClosed if the channel is closed,
+// or another error if t's kind is not Chan.
+func (t *Table) TryRecv() (*Table, bool, error) {
+	cv, err := t.chanv("Table.TryRecv", reflect.RecvDir)
+	if err != nil {
+		return nil, false, err
+	}
+	v, ok := cv.TryRecv()
+	switch {
+	case ok:
+		return t.child(v), true, nil
+	case v.IsValid(): // closed
+		return nil, false, &ErrClosed{"Table.TryRecv"}
+	default:
+		return nil, false, nil
+	}
+}
+
+// Close closes t's underlying channel.
+// Like close, it panics if the channel is closed already.
+// It returns error if t's kind is not Chan.
+func (t *Table) Close() error {
+	cv, err := t.chanv("Table.Close", reflect.SendDir)
+	if err != nil {
+		return err
+	}
+	cv.Close()
+	return nil
+}
@@ -0,0 +1,105 @@
+package table
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Chans", func() {
+	Context("with EachDoContext()", func() {
+		Specify("until closed", func() {
+			c := make(chan int, 3)
+			c <- 1
+			c <- 2
+			close(c)
+
+			var xs []int
+			err := New(c).EachDoContext(context.Background(), func(k, v *Table) error {
+				xs = append(xs, v.MustInt())
+				return nil
+			})
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(xs).Should(gomega.Equal([]int{1, 2}))
+		})
+		Specify("until ctx done", func() {
+			c := make(chan int)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			err := New(c).EachDoContext(ctx, func(k, v *Table) error {
+				return nil
+			})
+			gomega.Expect(err).Should(gomega.Equal(context.DeadlineExceeded))
+		})
+		Specify("with item timeout", func() {
+			c := make(chan int, 1)
+			c <- 1
+
+			n := 0
+			err := New(c).EachDoContext(context.Background(), func(k, v *Table) error {
+				n++
+				return nil
+			}, EachItemTimeout(10*time.Millisecond))
+			gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrTimeout)(nil)))
+			gomega.Expect(n).Should(gomega.Equal(1))
+		})
+		Specify("with max items", func() {
+			c := make(chan int, 3)
+			c <- 1
+			c <- 2
+			c <- 3
+
+			var xs []int
+			err := New(c).EachDoContext(context.Background(), func(k, v *Table) error {
+				xs = append(xs, v.MustInt())
+				return nil
+			}, EachMaxItems(2))
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(xs).Should(gomega.Equal([]int{1, 2}))
+			gomega.Expect(<-c).Should(gomega.Equal(3))
+		})
+		Specify("in slice", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			n := 0
+			err := New([]int{1, 2, 3}).EachDoContext(ctx, func(k, v *Table) error {
+				n++
+				cancel()
+				return nil
+			})
+			gomega.Expect(err).Should(gomega.Equal(context.Canceled))
+			gomega.Expect(n).Should(gomega.Equal(1))
+		})
+	})
+	Specify("with Send(), TrySend(), TryRecv() and Close()", func() {
+		c := make(chan int, 1)
+		t := New(c)
+
+		gomega.Expect(t.Send(1)).Should(gomega.BeNil())
+		gomega.Expect(t.TrySend(2)).Should(gomega.BeFalse())
+
+		v, ok, err := t.TryRecv()
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(ok).Should(gomega.BeTrue())
+		gomega.Expect(v.Int()).Should(gomega.Equal(1))
+
+		v, ok, err = t.TryRecv()
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(v).Should(gomega.BeNil())
+		gomega.Expect(ok).Should(gomega.BeFalse())
+
+		gomega.Expect(t.Close()).Should(gomega.BeNil())
+		v, ok, err = t.TryRecv()
+		gomega.Expect(err).Should(gomega.Equal(&ErrClosed{"Table.TryRecv"}))
+		gomega.Expect(v).Should(gomega.BeNil())
+		gomega.Expect(ok).Should(gomega.BeFalse())
+	})
+	Specify("with other kind", func() {
+		t := New(1)
+		gomega.Expect(t.Send(1)).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+		gomega.Expect(t.Close()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+		gomega.Expect(New(make(chan int)).Send("a")).To(gomega.BeAssignableToTypeOf((*ErrTypeUnequal)(nil)))
+	})
+})
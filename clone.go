@@ -0,0 +1,196 @@
+package table
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// CloneOption configures Clone.
+type CloneOption func(*cloneOptions)
+
+type cloneOptions struct {
+	unexported bool
+	shallow    bool
+	copiers    map[reflect.Type]func(reflect.Value) reflect.Value
+}
+
+// CloneUnexported also deep-copies unexported struct fields, through unsafe,
+// they're copied as is by default.
+func CloneUnexported() CloneOption {
+	return func(o *cloneOptions) {
+		o.unexported = true
+	}
+}
+
+// CloneShallow copies only the top map, array, slice or struct,
+// and its values are shared with the original.
+func CloneShallow() CloneOption {
+	return func(o *cloneOptions) {
+		o.shallow = true
+	}
+}
+
+// CloneWith copies values of the type T by f instead,
+// a nil of an interface type T is passed to f as the zero T.
+func CloneWith[T any](f func(T) T) CloneOption {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	return func(o *cloneOptions) {
+		if o.copiers == nil {
+			o.copiers = map[reflect.Type]func(reflect.Value) reflect.Value{}
+		}
+		o.copiers[typ] = func(v reflect.Value) reflect.Value {
+			x, _ := v.Interface().(T)
+			nv := reflect.New(typ).Elem()
+			if r := reflect.ValueOf(f(x)); r.IsValid() {
+				nv.Set(r)
+			}
+			return nv
+		}
+	}
+}
+
+// Clone returns a new Table of a deep copy of t's underlying value.
+//
+// Maps, arrays, slices, structs and pointers are copied, channels, functions
+// and unsafe pointers are shared. Values referenced more than once, including
+// cycles, are copied once and the copy is referenced the same way.
+// It returns t if it carries an error. A value got through an unexported
+// field can be cloned only with CloneUnexported and through a pointer, as
+// New(&v), otherwise the Table returned carries ErrUnsupportedKind.
+func (t *Table) Clone(opts ...CloneOption) *Table {
+	if t.check("Table.Clone") != nil {
+		return t
+	}
+
+	c := &cloner{memo: map[cloneRef]reflect.Value{}}
+	for _, opt := range opts {
+		opt(&c.cloneOptions)
+	}
+
+	v := t.getv()
+	if v.IsValid() && !v.CanInterface() {
+		if !c.unexported || !v.CanAddr() {
+			return &Table{path: t.path, err: &ErrPath{t.path, &ErrUnsupportedKind{"Table.Clone", "read-only"}}}
+		}
+		v = reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+	}
+	return valueTable(c.clone(v, 0))
+}
+
+type cloneRef struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+type cloner struct {
+	cloneOptions
+	memo map[cloneRef]reflect.Value
+}
+
+// clone returns a copy of v, depth is the depth of containers above v.
+func (c *cloner) clone(v reflect.Value, depth int) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	if f, ok := c.copiers[v.Type()]; ok && v.CanInterface() {
+		return f(v)
+	}
+	if c.shallow && depth > 0 {
+		return v
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		nv := reflect.New(v.Type()).Elem()
+		nv.Set(c.clone(v.Elem(), depth))
+		return nv
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		ref := cloneRef{v.Type(), v.Pointer(), 0}
+		if nv, ok := c.memo[ref]; ok {
+			return nv
+		}
+		nv := reflect.New(v.Type().Elem())
+		c.memo[ref] = nv
+		nv.Elem().Set(c.clone(v.Elem(), depth))
+		return nv
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		ref := cloneRef{v.Type(), v.Pointer(), 0}
+		if nv, ok := c.memo[ref]; ok {
+			return nv
+		}
+		nv := reflect.MakeMapWithSize(v.Type(), v.Len())
+		c.memo[ref] = nv
+		iter := v.MapRange()
+		for iter.Next() {
+			nv.SetMapIndex(c.clone(iter.Key(), depth+1), c.clone(iter.Value(), depth+1))
+		}
+		return nv
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		ref := cloneRef{v.Type(), v.Pointer(), v.Len()}
+		if nv, ok := c.memo[ref]; ok {
+			return nv
+		}
+		nv := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		c.memo[ref] = nv
+		for i := 0; i < v.Len(); i++ {
+			nv.Index(i).Set(c.clone(v.Index(i), depth+1))
+		}
+		return nv
+
+	case reflect.Array:
+		nv := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			nv.Index(i).Set(c.clone(v.Index(i), depth+1))
+		}
+		return nv
+
+	case reflect.Struct:
+		return c.cloneStruct(v, depth)
+
+	default:
+		return v
+	}
+}
+
+func (c *cloner) cloneStruct(v reflect.Value, depth int) reflect.Value {
+	vt := v.Type()
+	nv := reflect.New(vt).Elem()
+	nv.Set(v)
+
+	// an addressable copy to read unexported fields through unsafe
+	var src reflect.Value
+	if c.unexported {
+		src = reflect.New(vt).Elem()
+		src.Set(v)
+	}
+
+	for i := 0; i < vt.NumField(); i++ {
+		if vt.Field(i).PkgPath == "" {
+			nv.Field(i).Set(c.clone(v.Field(i), depth+1))
+			continue
+		}
+		if c.unexported {
+			sf, nf := src.Field(i), nv.Field(i)
+			sf = reflect.NewAt(sf.Type(), unsafe.Pointer(sf.UnsafeAddr())).Elem()
+			nf = reflect.NewAt(nf.Type(), unsafe.Pointer(nf.UnsafeAddr())).Elem()
+			nf.Set(c.clone(sf, depth+1))
+		}
+	}
+	return nv
+}
@@ -0,0 +1,123 @@
+package table
+
+import (
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Clone", func() {
+	type node struct {
+		Name string
+		Next *node
+		Tags []string
+		meta map[string]int
+	}
+
+	Specify("of maps and slices", func() {
+		x := map[string]interface{}{"a": []int{1, 2}, "b": map[string]int{"c": 3}}
+		c := New(x).Clone()
+		gomega.Expect(c.Err()).Should(gomega.BeNil())
+		gomega.Expect(c.Interface()).Should(gomega.Equal(x))
+
+		y := c.Interface().(map[string]interface{})
+		y["a"].([]int)[0] = 100
+		y["b"].(map[string]int)["c"] = 300
+		gomega.Expect(x["a"]).Should(gomega.Equal([]int{1, 2}))
+		gomega.Expect(x["b"]).Should(gomega.Equal(map[string]int{"c": 3}))
+	})
+	Specify("of arrays, structs and pointers", func() {
+		x := &node{Name: "a", Tags: []string{"t"}, Next: &node{Name: "b"}}
+		y := New(x).Clone().Interface().(*node)
+		gomega.Expect(y).ShouldNot(gomega.BeIdenticalTo(x))
+		gomega.Expect(y.Next).ShouldNot(gomega.BeIdenticalTo(x.Next))
+		gomega.Expect(y.Next.Name).Should(gomega.Equal("b"))
+		y.Tags[0] = "u"
+		gomega.Expect(x.Tags[0]).Should(gomega.Equal("t"))
+
+		a := [2][]int{{1}, {2}}
+		b := New(a).Clone().Interface().([2][]int)
+		b[0][0] = 100
+		gomega.Expect(a[0][0]).Should(gomega.Equal(1))
+	})
+	Specify("of nil values", func() {
+		gomega.Expect(New(nil).Clone().Interface()).Should(gomega.BeNil())
+		var s []int
+		gomega.Expect(New(s).Clone().Interface()).Should(gomega.BeNil())
+		var p *node
+		gomega.Expect(New(p).Clone().Interface()).Should(gomega.BeNil())
+	})
+	Specify("of shared references and cycles", func() {
+		shared := []int{1}
+		x := map[string]interface{}{"a": shared, "b": shared}
+		y := New(x).Clone().Interface().(map[string]interface{})
+		y["a"].([]int)[0] = 100
+		gomega.Expect(y["b"]).Should(gomega.Equal([]int{100}))
+		gomega.Expect(shared[0]).Should(gomega.Equal(1))
+
+		n := &node{Name: "a"}
+		n.Next = n
+		m := New(n).Clone().Interface().(*node)
+		gomega.Expect(m).ShouldNot(gomega.BeIdenticalTo(n))
+		gomega.Expect(m.Next).Should(gomega.BeIdenticalTo(m))
+	})
+	Specify("of unexported fields", func() {
+		x := &node{Name: "a", meta: map[string]int{"k": 1}}
+		y := New(x).Clone().Interface().(*node)
+		y.meta["k"] = 2
+		gomega.Expect(x.meta["k"]).Should(gomega.Equal(2))
+
+		x.meta["k"] = 1
+		y = New(x).Clone(CloneUnexported()).Interface().(*node)
+		y.meta["k"] = 2
+		gomega.Expect(x.meta["k"]).Should(gomega.Equal(1))
+	})
+	Specify("with options", func() {
+		x := map[string][]int{"a": {1}}
+		y := New(x).Clone(CloneShallow()).Interface().(map[string][]int)
+		y["b"] = []int{2}
+		y["a"][0] = 100
+		gomega.Expect(x).Should(gomega.Equal(map[string][]int{"a": {100}}))
+
+		z := New([]string{"a", "b"}).Clone(CloneWith(strings.ToUpper)).Interface()
+		gomega.Expect(z).Should(gomega.Equal([]string{"A", "B"}))
+	})
+	Specify("with copiers of interface types", func() {
+		errA := errors.New("a")
+		var seen []error
+		same := CloneWith(func(e error) error {
+			seen = append(seen, e)
+			return e
+		})
+		es := New([]error{nil, errA}).Clone(same).Interface()
+		gomega.Expect(es).Should(gomega.Equal([]error{nil, errA}))
+		gomega.Expect(seen).Should(gomega.Equal([]error{nil, errA}))
+
+		none := CloneWith(func(error) error { return nil })
+		gomega.Expect(New([]error{errA}).Clone(none).Interface()).Should(gomega.Equal([]error{nil}))
+	})
+	Specify("of values got through unexported fields", func() {
+		type outer struct {
+			A int
+			b *node
+		}
+		x := outer{1, &node{Name: "b", Tags: []string{"t"}}}
+		c := New(x).MustGetPath("b").Clone()
+		gomega.Expect(c.Err()).Should(gomega.Equal(&ErrPath{Path{"b"}, &ErrUnsupportedKind{"Table.Clone", "read-only"}}))
+		gomega.Expect(New(x).MustGetPath("b").Clone(CloneUnexported()).Err()).ShouldNot(gomega.BeNil())
+
+		c = New(&x).MustGetPath("b").Clone(CloneUnexported())
+		gomega.Expect(c.Err()).Should(gomega.BeNil())
+		y := c.Interface().(*node)
+		gomega.Expect(y).Should(gomega.Equal(x.b))
+		gomega.Expect(y).ShouldNot(gomega.BeIdenticalTo(x.b))
+		y.Tags[0] = "u"
+		gomega.Expect(x.b.Tags[0]).Should(gomega.Equal("t"))
+	})
+	Specify("with error", func() {
+		t := New(map[string]int{}).At("x")
+		gomega.Expect(t.Clone()).Should(gomega.BeIdenticalTo(t))
+	})
+})
@@ -0,0 +1,199 @@
+package table
+
+import (
+	"reflect"
+)
+
+// entries returns keys and values of t's underlying map, array, slice or struct
+// in the order of EachDo, unexported struct fields are passed.
+func (t *Table) entries(method string) (reflect.Value, []*Table, []*Table, error) {
+	if err := t.check(method); err != nil {
+		return reflect.Value{}, nil, nil, err
+	}
+
+	sv := indirect(t.getv())
+	switch sv.Kind() {
+	case reflect.Map, reflect.Array, reflect.Slice, reflect.Struct:
+	default:
+		return sv, nil, nil, &ErrUnsupportedKind{method, sv.Kind()}
+	}
+
+	var ks, vs []*Table
+	err := t.child(sv).each(method, &eachOptions{}, func(k, v *Table) bool {
+		if v.getv().CanInterface() {
+			ks = append(ks, k)
+			vs = append(vs, v)
+		}
+		return true
+	})
+	return sv, ks, vs, err
+}
+
+// Filter returns a new Table of the keys and values of t's underlying map,
+// array, slice or struct for which pred returns true.
+//
+// A map or slice is filtered to the same type, an array to a slice of its
+// element type, and a struct to a map[string]interface{} of its exported fields.
+func (t *Table) Filter(pred func(k, v *Table) bool) (*Table, error) {
+	sv, ks, vs, err := t.entries("Table.Filter")
+	if err != nil {
+		return nil, err
+	}
+
+	var nv reflect.Value
+	switch sv.Kind() {
+	case reflect.Map:
+		nv = reflect.MakeMap(sv.Type())
+	case reflect.Array, reflect.Slice:
+		nv = reflect.MakeSlice(reflect.SliceOf(sv.Type().Elem()), 0, len(vs))
+	case reflect.Struct:
+		nv = reflect.MakeMap(reflect.MapOf(_StringType, _InterfaceType))
+	}
+
+	for i, k := range ks {
+		if !pred(k, vs[i]) {
+			continue
+		}
+		switch sv.Kind() {
+		case reflect.Map:
+			nv.SetMapIndex(k.getv(), vs[i].getv())
+		case reflect.Array, reflect.Slice:
+			nv = reflect.Append(nv, vs[i].getv())
+		case reflect.Struct:
+			ev, _ := assignable(vs[i].getv(), _InterfaceType)
+			nv.SetMapIndex(k.getv(), ev)
+		}
+	}
+	return t.child(nv), nil
+}
+
+// MapValues returns a new Table of t's underlying map, array, slice or struct
+// with every value replaced by what fn returns for it.
+//
+// The new Table keeps the type of t while the new values are assignable to it,
+// otherwise a map becomes a map of interface{} values, an array or slice
+// becomes a []interface{}, and a struct becomes a map[string]interface{}
+// of its exported fields.
+func (t *Table) MapValues(fn func(k, v *Table) (interface{}, error)) (*Table, error) {
+	sv, ks, vs, err := t.entries("Table.MapValues")
+	if err != nil {
+		return nil, err
+	}
+
+	nvs := make([]reflect.Value, len(vs))
+	fit := true
+	for i, k := range ks {
+		x, err := fn(k, vs[i])
+		if err != nil {
+			return nil, err
+		}
+		nvs[i] = reflect.ValueOf(x)
+		if _, ok := assignable(nvs[i], vs[i].getv().Type()); !ok {
+			fit = false
+		}
+	}
+
+	var nv reflect.Value
+	switch {
+	case sv.Kind() == reflect.Map && fit:
+		nv = reflect.MakeMapWithSize(sv.Type(), len(ks))
+	case sv.Kind() == reflect.Map:
+		nv = reflect.MakeMapWithSize(reflect.MapOf(sv.Type().Key(), _InterfaceType), len(ks))
+	case sv.Kind() == reflect.Array && fit:
+		nv = reflect.New(sv.Type()).Elem()
+	case sv.Kind() == reflect.Slice && fit:
+		nv = reflect.MakeSlice(sv.Type(), len(vs), len(vs))
+	case sv.Kind() == reflect.Struct && fit:
+		nv = reflect.New(sv.Type()).Elem()
+		nv.Set(sv)
+	case sv.Kind() == reflect.Struct:
+		nv = reflect.MakeMapWithSize(reflect.MapOf(_StringType, _InterfaceType), len(ks))
+	default: // array or slice not fit
+		nv = reflect.MakeSlice(reflect.SliceOf(_InterfaceType), len(vs), len(vs))
+	}
+
+	for i, k := range ks {
+		switch nv.Kind() {
+		case reflect.Map:
+			ev, _ := assignable(nvs[i], nv.Type().Elem())
+			nv.SetMapIndex(k.getv(), ev)
+		case reflect.Array, reflect.Slice:
+			ev, _ := assignable(nvs[i], nv.Type().Elem())
+			nv.Index(k.geti().(int)).Set(ev)
+		case reflect.Struct:
+			f := nv.FieldByName(k.geti().(string))
+			ev, _ := assignable(nvs[i], f.Type())
+			f.Set(ev)
+		}
+	}
+	return t.child(nv), nil
+}
+
+// Reduce folds the keys and values of t's underlying map, array, slice or
+// struct into an accumulator, starting with init, in the order of EachDo.
+// It returns the last accumulator, or the first error fn returns.
+func (t *Table) Reduce(init interface{}, fn func(acc interface{}, k, v *Table) (interface{}, error)) (interface{}, error) {
+	_, ks, vs, err := t.entries("Table.Reduce")
+	if err != nil {
+		return nil, err
+	}
+
+	acc := init
+	for i, k := range ks {
+		if acc, err = fn(acc, k, vs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return acc, nil
+}
+
+// Find returns the first key and value of t's underlying map, array, slice or
+// struct for which pred returns true, in the order of EachDo.
+// It returns the nils if none is found.
+func (t *Table) Find(pred func(k, v *Table) bool) (*Table, *Table, error) {
+	_, ks, vs, err := t.entries("Table.Find")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, k := range ks {
+		if pred(k, vs[i]) {
+			return k, vs[i], nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// Any reports whether pred returns true for any key and value
+// of t's underlying map, array, slice or struct.
+func (t *Table) Any(pred func(k, v *Table) bool) (bool, error) {
+	k, _, err := t.Find(pred)
+	return k != nil, err
+}
+
+// Every reports whether pred returns true for every key and value
+// of t's underlying map, array, slice or struct.
+// It's true for empty ones.
+func (t *Table) Every(pred func(k, v *Table) bool) (bool, error) {
+	k, _, err := t.Find(func(k, v *Table) bool {
+		return !pred(k, v)
+	})
+	return k == nil && err == nil, err
+}
+
+// Count returns the number of keys and values of t's underlying map,
+// array, slice or struct for which pred returns true.
+func (t *Table) Count(pred func(k, v *Table) bool) (int, error) {
+	_, ks, vs, err := t.entries("Table.Count")
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for i, k := range ks {
+		if pred(k, vs[i]) {
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,93 @@
+package table
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Collections", func() {
+	even := func(_, v *Table) bool { return v.MustInt()%2 == 0 }
+
+	Context("with Filter()", func() {
+		Specify("of map", func() {
+			x := map[string]int{"a": 1, "b": 2, "c": 4}
+			gomega.Expect(must(New(x).Filter(even)).Interface()).Should(gomega.Equal(map[string]int{"b": 2, "c": 4}))
+			gomega.Expect(x).Should(gomega.HaveLen(3))
+		})
+		Specify("of slice and array", func() {
+			y := MustAs[[]int](must(New([]int{1, 2, 3, 4}).Filter(even)))
+			gomega.Expect(y).Should(gomega.Equal([]int{2, 4}))
+			y = MustAs[[]int](must(New([3]int{1, 2, 3}).Filter(even)))
+			gomega.Expect(y).Should(gomega.Equal([]int{2}))
+		})
+		Specify("of struct", func() {
+			x := struct{ A, B int }{1, 2}
+			y := must(New(&x).Filter(even))
+			gomega.Expect(y.Interface()).Should(gomega.Equal(map[string]interface{}{"B": 2}))
+		})
+		Specify("of other kind", func() {
+			ExpectErr(New(1).Filter(even)).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+		})
+	})
+	Context("with MapValues()", func() {
+		double := func(_, v *Table) (interface{}, error) { return v.MustInt() * 2, nil }
+		str := func(k, v *Table) (interface{}, error) { return v.MustString(), nil }
+
+		Specify("keeping the type", func() {
+			gomega.Expect(must(New(map[string]int{"a": 1}).MapValues(double)).Interface()).
+				Should(gomega.Equal(map[string]int{"a": 2}))
+			gomega.Expect(must(New([]int{1, 2}).MapValues(double)).Interface()).
+				Should(gomega.Equal([]int{2, 4}))
+			gomega.Expect(must(New([2]int{1, 2}).MapValues(double)).Interface()).
+				Should(gomega.Equal([2]int{2, 4}))
+			gomega.Expect(must(New(struct{ A, B int }{1, 2}).MapValues(double)).Interface()).
+				Should(gomega.Equal(struct{ A, B int }{2, 4}))
+		})
+		Specify("changing the type", func() {
+			gomega.Expect(must(New(map[string]int{"a": 1}).MapValues(str)).Interface()).
+				Should(gomega.Equal(map[string]interface{}{"a": "1"}))
+			gomega.Expect(must(New([]int{1, 2}).MapValues(str)).Interface()).
+				Should(gomega.Equal([]interface{}{"1", "2"}))
+			gomega.Expect(must(New(struct{ A int }{1}).MapValues(str)).Interface()).
+				Should(gomega.Equal(map[string]interface{}{"A": "1"}))
+		})
+		Specify("with error", func() {
+			e := errors.New("e")
+			_, err := New([]int{1}).MapValues(func(_, _ *Table) (interface{}, error) { return nil, e })
+			gomega.Expect(err).Should(gomega.Equal(e))
+		})
+	})
+	Specify("with Reduce()", func() {
+		sum, err := New(map[string]float64{"a": 1, "b": 2.5}).Reduce(0.0, func(acc interface{}, _, v *Table) (interface{}, error) {
+			return acc.(float64) + v.MustFloat64(), nil
+		})
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(sum).Should(gomega.Equal(3.5))
+	})
+	Specify("with Find(), Any(), Every() and Count()", func() {
+		x := []int{1, 2, 3, 4}
+		k, v, err := New(x).Find(even)
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(k.MustInt()).Should(gomega.Equal(1))
+		gomega.Expect(v.MustInt()).Should(gomega.Equal(2))
+
+		k, v, _ = New([]int{1, 3}).Find(even)
+		gomega.Expect(k).Should(gomega.BeNil())
+		gomega.Expect(v).Should(gomega.BeNil())
+
+		gomega.Expect(New(x).Any(even)).Should(gomega.BeTrue())
+		gomega.Expect(New([]int{1}).Any(even)).Should(gomega.BeFalse())
+		gomega.Expect(New(x).Every(even)).Should(gomega.BeFalse())
+		gomega.Expect(New([]int{2, 4}).Every(even)).Should(gomega.BeTrue())
+		gomega.Expect(New([]int{}).Every(even)).Should(gomega.BeTrue())
+		gomega.Expect(New(x).Count(even)).Should(gomega.Equal(2))
+	})
+})
+
+// must returns t, failing the spec if err is not nil.
+func must(t *Table, err error) *Table {
+	gomega.ExpectWithOffset(1, err).Should(gomega.BeNil())
+	return t
+}
@@ -0,0 +1,214 @@
+package table
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// order ranks of the kinds of values, values of different ranks
+// are ordered by their ranks.
+const (
+	rankNil = iota
+	rankBool
+	rankNumber
+	rankString
+	rankOther
+)
+
+func orderRank(v reflect.Value) int {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return rankNil
+	case reflect.Bool:
+		return rankBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return rankNumber
+	case reflect.String:
+		return rankString
+	default:
+		return rankOther
+	}
+}
+
+// compareValues returns -1, 0 or +1 as a is less than, equal to or greater than b
+// in natural order: nil < bool < number < string < others.
+// Numbers of any kinds are compared by value, others are compared by
+// their formatted strings.
+func compareValues(a, b reflect.Value) int {
+	a, b = indirect(a), indirect(b)
+	ra, rb := orderRank(a), orderRank(b)
+	if ra != rb {
+		return compareInts(int64(ra), int64(rb))
+	}
+
+	switch ra {
+	case rankNil:
+		return 0
+	case rankBool:
+		ab, bb := a.Bool(), b.Bool()
+		switch {
+		case ab == bb:
+			return 0
+		case !ab:
+			return -1
+		default:
+			return 1
+		}
+	case rankNumber:
+		return compareNumbers(a, b)
+	case rankString:
+		return strings.Compare(a.String(), b.String())
+	default:
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	}
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareUints(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareFloats orders NaN before all other floats.
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	case a == b:
+		return 0
+	case math.IsNaN(a) && math.IsNaN(b):
+		return 0
+	case math.IsNaN(a):
+		return -1
+	default:
+		return 1
+	}
+}
+
+func isIntKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUintKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// compareNumbers compares two int*, uint* or float* values.
+func compareNumbers(a, b reflect.Value) int {
+	ak, bk := a.Kind(), b.Kind()
+	switch {
+	case isIntKind(ak) && isIntKind(bk):
+		return compareInts(a.Int(), b.Int())
+	case isUintKind(ak) && isUintKind(bk):
+		return compareUints(a.Uint(), b.Uint())
+	case isIntKind(ak) && isUintKind(bk):
+		if a.Int() < 0 {
+			return -1
+		}
+		return compareUints(uint64(a.Int()), b.Uint())
+	case isUintKind(ak) && isIntKind(bk):
+		return -compareNumbers(b, a)
+	default:
+		return compareFloats(numberFloat(a), numberFloat(b))
+	}
+}
+
+func numberFloat(v reflect.Value) float64 {
+	switch {
+	case isIntKind(v.Kind()):
+		return float64(v.Int())
+	case isUintKind(v.Kind()):
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+// arith returns the arithmetic op of the numbers l and r,
+// an int64 if both are integers and op is not "/", a float64 otherwise.
+func arith(method, op string, l, r interface{}) (interface{}, error) {
+	lv, rv := reflect.ValueOf(l), reflect.ValueOf(r)
+	for _, v := range []reflect.Value{lv, rv} {
+		if orderRank(v) != rankNumber {
+			return nil, &ErrUnsupportedKind{method, v.Kind()}
+		}
+	}
+
+	if isInteger(lv) && isInteger(rv) && op != "/" {
+		a, b := integerOf(lv), integerOf(rv)
+		switch op {
+		case "+":
+			return a + b, nil
+		case "-":
+			return a - b, nil
+		case "*":
+			return a * b, nil
+		case "%":
+			if b == 0 {
+				return nil, &ErrOutOfRange{method}
+			}
+			return a % b, nil
+		}
+	}
+
+	a, b := numberFloat(lv), numberFloat(rv)
+	switch op {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	case "/":
+		if b == 0 {
+			return nil, &ErrOutOfRange{method}
+		}
+		return a / b, nil
+	default: // %
+		if b == 0 {
+			return nil, &ErrOutOfRange{method}
+		}
+		return math.Mod(a, b), nil
+	}
+}
+
+func isInteger(v reflect.Value) bool {
+	return isIntKind(v.Kind()) || isUintKind(v.Kind())
+}
+
+func integerOf(v reflect.Value) int64 {
+	if isUintKind(v.Kind()) {
+		return int64(v.Uint())
+	}
+	return v.Int()
+}
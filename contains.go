@@ -0,0 +1,58 @@
+package table
+
+import (
+	"reflect"
+)
+
+// ContainsKey reports whether t's underlying map, array, slice or struct
+// has the key k, which is matched like GetPath does.
+func (t *Table) ContainsKey(k interface{}) bool {
+	return t.Has(Path{k})
+}
+
+// ContainsValue reports whether any value of t's underlying map, array,
+// slice or struct equals v.
+// Numbers of different kinds are equal if they are of the same value.
+func (t *Table) ContainsValue(v interface{}) bool {
+	return t.IndexOf(v) >= 0
+}
+
+// IndexOf returns the position of the first value equal to v in the order
+// of EachDo, which for arrays and slices is the index.
+// It returns -1 if no value equals v or t's kind is not Map, Array, Slice or Struct.
+func (t *Table) IndexOf(v interface{}) int {
+	if !t.IsContainer() {
+		return -1
+	}
+
+	x := reflect.ValueOf(v)
+	idx, found := 0, false
+	_ = t.each("Table.IndexOf", &eachOptions{}, func(_, ev *Table) bool {
+		if valueEqual(ev.getv(), x) {
+			found = true
+			return false
+		}
+		idx++
+		return true
+	})
+	if !found {
+		return -1
+	}
+	return idx
+}
+
+// valueEqual reports whether a and b are equal, seeing through interfaces
+// and pointers. Numbers are compared by value, others are deeply equal.
+func valueEqual(a, b reflect.Value) bool {
+	a, b = indirect(a), indirect(b)
+	if orderRank(a) == rankNumber && orderRank(b) == rankNumber {
+		return compareNumbers(a, b) == 0
+	}
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if !a.CanInterface() || !b.CanInterface() {
+		return false
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
@@ -0,0 +1,44 @@
+package table
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Contains", func() {
+	m := map[string]interface{}{"b": 2.0, "a": "x", "c": []int{1}}
+	s := []interface{}{"x", 2, nil}
+	st := struct{ A, B int }{1, 2}
+
+	Specify("with ContainsKey()", func() {
+		gomega.Expect(New(m).ContainsKey("a")).To(gomega.BeTrue())
+		gomega.Expect(New(m).ContainsKey("z")).To(gomega.BeFalse())
+		gomega.Expect(New(map[int]int{1: 1}).ContainsKey(1)).To(gomega.BeTrue())
+		gomega.Expect(New(s).ContainsKey(2)).To(gomega.BeTrue())
+		gomega.Expect(New(s).ContainsKey(3)).To(gomega.BeFalse())
+		gomega.Expect(New(&st).ContainsKey("B")).To(gomega.BeTrue())
+		gomega.Expect(New(1).ContainsKey(1)).To(gomega.BeFalse())
+	})
+	Specify("with ContainsValue()", func() {
+		gomega.Expect(New(m).ContainsValue(2)).To(gomega.BeTrue())
+		gomega.Expect(New(m).ContainsValue([]int{1})).To(gomega.BeTrue())
+		gomega.Expect(New(m).ContainsValue("y")).To(gomega.BeFalse())
+		gomega.Expect(New(s).ContainsValue(nil)).To(gomega.BeTrue())
+		gomega.Expect(New(st).ContainsValue(uint(2))).To(gomega.BeTrue())
+		gomega.Expect(New("x").ContainsValue("x")).To(gomega.BeFalse())
+	})
+	Specify("with IndexOf()", func() {
+		gomega.Expect(New(s).IndexOf(2.0)).To(gomega.Equal(1))
+		gomega.Expect(New(s).IndexOf("y")).To(gomega.Equal(-1))
+		gomega.Expect(New(m).IndexOf("x")).To(gomega.Equal(0)) // key "a" is first
+		gomega.Expect(New(st).IndexOf(2)).To(gomega.Equal(1))
+	})
+	Specify("with Keys() and Len()", func() {
+		var ks []string
+		for k := range New(m).Keys() {
+			ks = append(ks, k.MustString())
+		}
+		gomega.Expect(ks).To(gomega.Equal([]string{"a", "b", "c"}))
+		gomega.Expect(New(m).Len()).To(gomega.Equal(3))
+	})
+})
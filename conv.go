@@ -36,6 +36,10 @@ var (
 
 // ConvTo convToert t to value
 func (t *Table) ConvTo(value interface{}) error {
+	if err := t.check("Table.ConvTo"); err != nil {
+		return err
+	}
+
 	v := reflect.ValueOf(value)
 	if v.Kind() != reflect.Ptr {
 		return &ErrUnsupportedKind{"Table.ConvTo", v.Kind()}
@@ -134,7 +138,11 @@ func (t *Table) convToPtr(v reflect.Value) error {
 }
 
 func (t *Table) convToInterface(v reflect.Value) error {
-	v.Set(t.getv())
+	tv, ok := assignable(t.getv(), v.Type())
+	if !ok {
+		return &ErrTypeUnequal{"Table.convToInterface", v.Kind(), tv.Kind()}
+	}
+	v.Set(tv)
 	return nil
 }
 
@@ -0,0 +1,201 @@
+package table
+
+import (
+	"reflect"
+	"sort"
+	"time"
+)
+
+// OrderedMap is implemented by map-like values which remember the insertion
+// order of their keys, such as ordered maps decoded from JSON or YAML.
+type OrderedMap interface {
+	// OrderedKeys returns the keys in insertion order.
+	OrderedKeys() []interface{}
+	// OrderedValue returns the value of the key k.
+	OrderedValue(k interface{}) interface{}
+}
+
+// EachOption configures the iteration of EachDo and EachDoContext.
+//
+// Arrays, slices and structs are always iterated in their natural order,
+// maps are iterated in natural key order by default.
+type EachOption func(*eachOptions)
+
+type eachOptions struct {
+	less          func(a, b *Table) bool
+	unordered     bool
+	insertion     bool
+	maxItems      int
+	itemTimeout   time.Duration
+	collectErrors bool
+}
+
+// EachKeyLess iterates maps in the key order of less.
+func EachKeyLess(less func(a, b *Table) bool) EachOption {
+	return func(o *eachOptions) {
+		o.less = less
+	}
+}
+
+// EachInsertionOrder iterates an OrderedMap in its insertion order,
+// other maps are still iterated in key order.
+func EachInsertionOrder() EachOption {
+	return func(o *eachOptions) {
+		o.insertion = true
+	}
+}
+
+// EachUnordered iterates maps in Go's random map order, which is the cheapest.
+func EachUnordered() EachOption {
+	return func(o *eachOptions) {
+		o.unordered = true
+	}
+}
+
+// EachMaxItems stops iterating after n items.
+func EachMaxItems(n int) EachOption {
+	return func(o *eachOptions) {
+		o.maxItems = n
+	}
+}
+
+// EachItemTimeout limits the time EachDoContext waits for every item of
+// a channel, it returns ErrTimeout if the time is out.
+func EachItemTimeout(d time.Duration) EachOption {
+	return func(o *eachOptions) {
+		o.itemTimeout = d
+	}
+}
+
+func newEachOptions(opts []EachOption) *eachOptions {
+	o := &eachOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// each calls f with every key and value of t in the order of o,
+// until f returns false.
+//
+// Scalars are iterated as a value of the nil key, channels are iterated
+// until closed, and keys of them are indexes.
+func (t *Table) each(method string, o *eachOptions, f func(k, v *Table) bool) error {
+	if err := t.check(method); err != nil {
+		return err
+	}
+
+	if t.frozen {
+		g := f
+		f = func(k, v *Table) bool {
+			return g(k.freeze(), v.freeze())
+		}
+	}
+
+	if o.maxItems > 0 {
+		n, g := 0, f
+		f = func(k, v *Table) bool {
+			if n >= o.maxItems {
+				return false
+			}
+			n++
+			return g(k, v)
+		}
+	}
+
+	if o.insertion {
+		if om, ok := t.orderedMap(); ok {
+			for _, k := range om.OrderedKeys() {
+				if !f(New(k), New(om.OrderedValue(k))) {
+					break
+				}
+			}
+			return nil
+		}
+	}
+
+	v := t.getv()
+	switch v.Kind() {
+	case reflect.Map:
+		if o.unordered {
+			iter := v.MapRange()
+			for iter.Next() {
+				if !f(&Table{v: iter.Key()}, &Table{v: iter.Value()}) {
+					break
+				}
+			}
+			return nil
+		}
+		for _, k := range sortedMapKeys(v, o.less) {
+			if !f(&Table{v: k}, &Table{v: v.MapIndex(k)}) {
+				break
+			}
+		}
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if !f(&Table{i: i}, &Table{v: v.Index(i)}) {
+				break
+			}
+		}
+	case reflect.Struct:
+		vt := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if !f(&Table{i: vt.Field(i).Name}, &Table{v: v.Field(i)}) {
+				break
+			}
+		}
+	case reflect.Chan:
+		for idx := 0; o.maxItems <= 0 || idx < o.maxItems; idx++ {
+			ev, ok := v.Recv()
+			if !ok || !f(&Table{i: idx}, &Table{v: ev}) {
+				break
+			}
+		}
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+
+		f(nil, t)
+
+	case reflect.Interface, reflect.Ptr:
+		return (&Table{v: indirect(v)}).each(method, o, f)
+
+	default:
+		return &ErrUnsupportedKind{method, v.Kind()}
+	}
+	return nil
+}
+
+// orderedMap returns t's underlying value, or what it points to, as an OrderedMap.
+func (t *Table) orderedMap() (OrderedMap, bool) {
+	v := t.getv()
+	for {
+		if v.IsValid() && v.CanInterface() {
+			if om, ok := v.Interface().(OrderedMap); ok {
+				return om, true
+			}
+		}
+		if v.Kind() != reflect.Interface && v.Kind() != reflect.Ptr {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+}
+
+// sortedMapKeys returns keys of the map m sorted by less,
+// or in natural order if less is nil.
+func sortedMapKeys(m reflect.Value, less func(a, b *Table) bool) []reflect.Value {
+	keys := m.MapKeys()
+	if less != nil {
+		sort.SliceStable(keys, func(i, j int) bool {
+			return less(&Table{v: keys[i]}, &Table{v: keys[j]})
+		})
+	} else {
+		sort.SliceStable(keys, func(i, j int) bool {
+			return compareValues(keys[i], keys[j]) < 0
+		})
+	}
+	return keys
+}
@@ -0,0 +1,72 @@
+package table
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+type orderedPairs [][2]interface{}
+
+func (p orderedPairs) OrderedKeys() []interface{} {
+	ks := make([]interface{}, len(p))
+	for i, kv := range p {
+		ks[i] = kv[0]
+	}
+	return ks
+}
+
+func (p orderedPairs) OrderedValue(k interface{}) interface{} {
+	for _, kv := range p {
+		if kv[0] == k {
+			return kv[1]
+		}
+	}
+	return nil
+}
+
+func eachKeys(t *Table, opts ...EachOption) []interface{} {
+	var ks []interface{}
+	err := t.EachDo(func(k, v *Table) error {
+		ks = append(ks, k.Interface())
+		return nil
+	}, opts...)
+	gomega.Expect(err).Should(gomega.BeNil())
+	return ks
+}
+
+var _ = Describe("EachDo order", func() {
+	Specify("of slice", func() {
+		gomega.Expect(eachKeys(New([]string{"a", "b", "c"}))).Should(gomega.Equal([]interface{}{0, 1, 2}))
+	})
+	Specify("of struct", func() {
+		x := struct{ C, A, B int }{}
+		gomega.Expect(eachKeys(New(&x))).Should(gomega.Equal([]interface{}{"C", "A", "B"}))
+	})
+	Specify("of map in natural key order", func() {
+		x := map[interface{}]int{"b": 1, 2: 2, "a": 3, 1.5: 4, -1: 5, uint(3): 6, nil: 7}
+		gomega.Expect(eachKeys(New(x))).Should(gomega.Equal([]interface{}{nil, -1, 1.5, 2, uint(3), "a", "b"}))
+	})
+	Specify("of map with key less", func() {
+		x := map[string]int{"a": 1, "b": 2, "c": 3}
+		desc := EachKeyLess(func(a, b *Table) bool {
+			as, _ := a.String()
+			bs, _ := b.String()
+			return as > bs
+		})
+		gomega.Expect(eachKeys(New(x), desc)).Should(gomega.Equal([]interface{}{"c", "b", "a"}))
+	})
+	Specify("of ordered map in insertion order", func() {
+		x := orderedPairs{{"b", 1}, {"a", 2}}
+		var vs []interface{}
+		err := New(x).EachDo(func(k, v *Table) error {
+			vs = append(vs, k.Interface(), v.Interface())
+			return nil
+		}, EachInsertionOrder())
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(vs).Should(gomega.Equal([]interface{}{"b", 1, "a", 2}))
+	})
+	Specify("of map unordered", func() {
+		x := map[string]int{"a": 1, "b": 2, "c": 3}
+		gomega.Expect(eachKeys(New(x), EachUnordered())).Should(gomega.ConsistOf("a", "b", "c"))
+	})
+})
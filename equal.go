@@ -0,0 +1,460 @@
+package table
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// EqualOption configures Equal.
+type EqualOption func(*equalOptions)
+
+type equalOptions struct {
+	looseNumbers bool
+	tolerance    float64
+	nilEmpty     bool
+	ignores      []Path
+}
+
+// EqualLooseNumbers compares numbers of any kinds by value, e.g. int(1)
+// equals float64(1), they must be of the same kind by default.
+func EqualLooseNumbers() EqualOption {
+	return func(o *equalOptions) {
+		o.looseNumbers = true
+	}
+}
+
+// EqualTolerance compares numbers of any kinds by value,
+// and they are equal if they differ at most by tolerance.
+func EqualTolerance(tolerance float64) EqualOption {
+	return func(o *equalOptions) {
+		o.looseNumbers = true
+		o.tolerance = tolerance
+	}
+}
+
+// EqualNilEmpty makes nil equal to empty maps, arrays, slices and structs.
+func EqualNilEmpty() EqualOption {
+	return func(o *equalOptions) {
+		o.nilEmpty = true
+	}
+}
+
+// EqualIgnorePaths ignores values at the dotted paths, e.g. "a.0.b",
+// the key "*" in a path matches any key, and the empty path is the root.
+func EqualIgnorePaths(paths ...string) EqualOption {
+	return func(o *equalOptions) {
+		for _, p := range paths {
+			o.ignores = append(o.ignores, ParsePath(p))
+		}
+	}
+}
+
+// Equal reports whether a and b hold equal data, even if their types differ.
+//
+// Values are compared by their Kind. Arrays and slices are equal if their
+// elements are equal in order, maps and structs are equal if they have equal
+// values of the same keys, where struct fields are keyed by their table tags
+// or names as of Flatten, and numbers as map keys by value. Keys of a map
+// equal by value, e.g. 1 and 1.0 of a map[interface{}]interface{}, are all
+// kept and compared in the order of their types' names.
+// Nil maps and slices are nil. Unexported struct fields are passed,
+// and cycles are equal if they're met at the same time.
+// The nil *Table and Tables carrying errors are nil.
+func Equal(a, b *Table, opts ...EqualOption) bool {
+	e := &equaler{seen: map[[2]walkPtr]bool{}}
+	for _, opt := range opts {
+		opt(&e.equalOptions)
+	}
+	return e.equal(Path{}, a.value(), b.value())
+}
+
+type equaler struct {
+	equalOptions
+	seen map[[2]walkPtr]bool
+}
+
+func (e *equaler) equal(path Path, a, b reflect.Value) bool {
+	if e.ignored(path) {
+		return true
+	}
+	a, b = indirect(a), indirect(b)
+	ka, kb := valueKind(a), valueKind(b)
+	if ka != kb {
+		if e.nilEmpty && (ka == KindNull || kb == KindNull) {
+			return valueLen(a) == 0 && valueLen(b) == 0
+		}
+		return false
+	}
+
+	if ra, ok := valueRef(a); ok {
+		if rb, ok := valueRef(b); ok {
+			pair := [2]walkPtr{ra, rb}
+			if e.seen[pair] {
+				return true
+			}
+			e.seen[pair] = true
+			defer delete(e.seen, pair)
+		}
+	}
+
+	switch ka {
+	case KindNull:
+		return true
+	case KindBool:
+		return a.Bool() == b.Bool()
+	case KindNumber:
+		return e.equalNumbers(a, b)
+	case KindString:
+		return a.String() == b.String()
+	case KindArray:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !e.equal(path.append(i), a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case KindObject:
+		ma, mb := objectEntries(a), objectEntries(b)
+		for k, as := range ma {
+			as, bs := e.unignored(path, as), e.unignored(path, mb[k])
+			if len(as) != len(bs) {
+				return false
+			}
+			for i, av := range as {
+				if !e.equal(path.append(av.key), av.value, bs[i].value) {
+					return false
+				}
+			}
+		}
+		for k, bs := range mb {
+			if _, ok := ma[k]; !ok && len(e.unignored(path, bs)) > 0 {
+				return false
+			}
+		}
+		return true
+	default:
+		return a.Type() == b.Type() && a.CanInterface() && b.CanInterface() &&
+			reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+}
+
+func (e *equaler) equalNumbers(a, b reflect.Value) bool {
+	if !e.looseNumbers && a.Kind() != b.Kind() {
+		return false
+	}
+	ca, cb := numberComplex(a), numberComplex(b)
+	if e.tolerance > 0 {
+		return math.Abs(real(ca)-real(cb)) <= e.tolerance && math.Abs(imag(ca)-imag(cb)) <= e.tolerance
+	}
+	if isComplexKind(a.Kind()) || isComplexKind(b.Kind()) {
+		return ca == cb
+	}
+	return compareNumbers(a, b) == 0
+}
+
+// unignored returns the entries at path not ignored.
+func (e *equaler) unignored(path Path, es []objectEntry) []objectEntry {
+	var r []objectEntry
+	for _, en := range es {
+		if !e.ignored(path.append(en.key)) {
+			r = append(r, en)
+		}
+	}
+	return r
+}
+
+// ignored reports whether path is ignored.
+func (e *equaler) ignored(path Path) bool {
+next:
+	for _, p := range e.ignores {
+		if len(p) != len(path) {
+			continue
+		}
+		for i, k := range p {
+			if k != "*" && k != fmt.Sprint(path[i]) {
+				continue next
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Compare returns -1, 0 or +1 as a is less than, equal to or greater than b,
+// in a total order of values of any types.
+//
+// Values are ordered by their kinds: null < bool < number < string < array
+// < object < other. Numbers of any kinds are compared by value, arrays and
+// slices are compared by elements in order, and maps and structs are compared
+// by their values in the natural order of their keys as of EachDo, keyed like
+// Equal does. Others are compared by their formatted strings.
+func Compare(a, b *Table) int {
+	return compareDeep(a.value(), b.value(), map[[2]walkPtr]bool{})
+}
+
+func compareDeep(a, b reflect.Value, seen map[[2]walkPtr]bool) int {
+	a, b = indirect(a), indirect(b)
+	ka, kb := valueKind(a), valueKind(b)
+	if ka != kb {
+		return compareInts(int64(ka), int64(kb))
+	}
+
+	if ra, ok := valueRef(a); ok {
+		if rb, ok := valueRef(b); ok {
+			pair := [2]walkPtr{ra, rb}
+			if seen[pair] {
+				return 0
+			}
+			seen[pair] = true
+			defer delete(seen, pair)
+		}
+	}
+
+	switch ka {
+	case KindNumber:
+		if isComplexKind(a.Kind()) || isComplexKind(b.Kind()) {
+			ca, cb := numberComplex(a), numberComplex(b)
+			if c := compareFloats(real(ca), real(cb)); c != 0 {
+				return c
+			}
+			return compareFloats(imag(ca), imag(cb))
+		}
+		return compareNumbers(a, b)
+	case KindArray:
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			if c := compareDeep(a.Index(i), b.Index(i), seen); c != 0 {
+				return c
+			}
+		}
+		return compareInts(int64(a.Len()), int64(b.Len()))
+	case KindObject:
+		ea, eb := sortedEntries(a), sortedEntries(b)
+		for i := 0; i < len(ea) && i < len(eb); i++ {
+			if c := compareValues(reflect.ValueOf(ea[i].key), reflect.ValueOf(eb[i].key)); c != 0 {
+				return c
+			}
+			if c := compareDeep(ea[i].value, eb[i].value, seen); c != 0 {
+				return c
+			}
+		}
+		return compareInts(int64(len(ea)), int64(len(eb)))
+	default:
+		return compareValues(a, b)
+	}
+}
+
+// Hash returns a structural hash of t's underlying value, which is equal for
+// values equal as of Compare, and stable across processes.
+// It returns error if any value is a channel, function or unsafe pointer.
+func (t *Table) Hash() (uint64, error) {
+	if err := t.check("Table.Hash"); err != nil {
+		return 0, err
+	}
+
+	h := &hasher{sum: fnv.New64a(), seen: map[walkPtr]bool{}}
+	if err := h.hash(t.getv()); err != nil {
+		return 0, err
+	}
+	return h.sum.Sum64(), nil
+}
+
+type hasher struct {
+	sum  hash.Hash64
+	seen map[walkPtr]bool
+}
+
+// write writes the tag of a value and its data.
+func (h *hasher) write(tag byte, xs ...uint64) {
+	var buf [9]byte
+	buf[0] = tag
+	h.sum.Write(buf[:1])
+	for _, x := range xs {
+		binary.LittleEndian.PutUint64(buf[1:], x)
+		h.sum.Write(buf[1:])
+	}
+}
+
+func (h *hasher) hash(v reflect.Value) error {
+	v = indirect(v)
+	if r, ok := valueRef(v); ok {
+		if h.seen[r] {
+			h.write('r')
+			return nil
+		}
+		h.seen[r] = true
+		defer delete(h.seen, r)
+	}
+
+	switch valueKind(v) {
+	case KindNull:
+		h.write('n')
+	case KindBool:
+		if v.Bool() {
+			h.write('b', 1)
+		} else {
+			h.write('b', 0)
+		}
+	case KindNumber:
+		c := numberComplex(v)
+		if imag(c) != 0 {
+			h.write('c', math.Float64bits(real(c)), math.Float64bits(imag(c)))
+			break
+		}
+		if isComplexKind(v.Kind()) {
+			v = reflect.ValueOf(real(c))
+		}
+		switch k, _ := hashKey("Table.Hash", v); k := k.(type) {
+		case int64:
+			h.write('i', uint64(k))
+		case uint64:
+			h.write('u', k)
+		case float64:
+			h.write('f', math.Float64bits(k))
+		case nanKey:
+			h.write('f', math.Float64bits(math.NaN()))
+		}
+	case KindString:
+		s := v.String()
+		h.write('s', uint64(len(s)))
+		h.sum.Write([]byte(s))
+	case KindArray:
+		h.write('a', uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			if err := h.hash(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case KindObject:
+		es := sortedEntries(v)
+		h.write('o', uint64(len(es)))
+		for _, e := range es {
+			if err := h.hash(reflect.ValueOf(e.key)); err != nil {
+				return err
+			}
+			if err := h.hash(e.value); err != nil {
+				return err
+			}
+		}
+	default:
+		return &ErrUnsupportedKind{"Table.Hash", v.Kind()}
+	}
+	return nil
+}
+
+type objectEntry struct {
+	key   interface{}
+	value reflect.Value
+}
+
+// objectEntries returns the entries of the map or struct v by their keys as
+// of hashKey, the entries of keys equal by value are in the order of their
+// types' names. Struct fields are keyed by their table tags or names, fields
+// tagged "_" and unexported ones are passed.
+func objectEntries(v reflect.Value) map[interface{}][]objectEntry {
+	m := map[interface{}][]objectEntry{}
+	switch v.Kind() {
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			k, err := hashKey("table.Equal", indirect(iter.Key()))
+			if err != nil || !iter.Key().CanInterface() {
+				continue
+			}
+			m[k] = append(m[k], objectEntry{iter.Key().Interface(), iter.Value()})
+		}
+		for _, es := range m {
+			if len(es) > 1 {
+				sort.Slice(es, func(i, j int) bool {
+					return entryTypeName(es[i]) < entryTypeName(es[j])
+				})
+			}
+		}
+	case reflect.Struct:
+		vt := v.Type()
+		for i := 0; i < vt.NumField(); i++ {
+			sf := vt.Field(i)
+			name := sf.Tag.Get("table")
+			if sf.PkgPath != "" || name == "_" {
+				continue
+			}
+			if name == "" {
+				name = sf.Name
+			}
+			m[name] = []objectEntry{{name, v.Field(i)}}
+		}
+	}
+	return m
+}
+
+// entryTypeName returns the name of the type of e's key,
+// to order the entries of keys equal by value.
+func entryTypeName(e objectEntry) string {
+	return fmt.Sprintf("%T", e.key)
+}
+
+// sortedEntries returns the entries of the map or struct v in natural key order,
+// and keys equal by value in the order of their types' names.
+func sortedEntries(v reflect.Value) []objectEntry {
+	var es []objectEntry
+	for _, kes := range objectEntries(v) {
+		es = append(es, kes...)
+	}
+	sort.SliceStable(es, func(i, j int) bool {
+		if c := compareValues(reflect.ValueOf(es[i].key), reflect.ValueOf(es[j].key)); c != 0 {
+			return c < 0
+		}
+		return entryTypeName(es[i]) < entryTypeName(es[j])
+	})
+	return es
+}
+
+// valueRef returns the reference of the map, slice or addressable value v,
+// to detect cycles.
+func valueRef(v reflect.Value) (walkPtr, bool) {
+	switch {
+	case (v.Kind() == reflect.Map || v.Kind() == reflect.Slice) && !v.IsNil():
+		return walkPtr{v.Type(), v.Pointer()}, true
+	case v.CanAddr():
+		return walkPtr{v.Type(), v.Addr().Pointer()}, true
+	default:
+		return walkPtr{}, false
+	}
+}
+
+// valueLen returns the length of the container v, 0 for nil.
+func valueLen(v reflect.Value) int {
+	switch valueKind(v) {
+	case KindArray, KindObject:
+		if v.Kind() == reflect.Struct {
+			n := 0
+			for _, es := range objectEntries(v) {
+				n += len(es)
+			}
+			return n
+		}
+		return v.Len()
+	case KindNull:
+		return 0
+	default:
+		return -1
+	}
+}
+
+func isComplexKind(k reflect.Kind) bool {
+	return k == reflect.Complex64 || k == reflect.Complex128
+}
+
+func numberComplex(v reflect.Value) complex128 {
+	if isComplexKind(v.Kind()) {
+		return v.Complex()
+	}
+	return complex(numberFloat(v), 0)
+}
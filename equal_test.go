@@ -0,0 +1,121 @@
+package table
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Equal", func() {
+	type item struct {
+		ID    int     `table:"id"`
+		Price float64 `table:"price"`
+		Tags  []string
+		skip  int
+	}
+	typed := item{1, 2.5, []string{"a"}, 0}
+	decoded := map[string]interface{}{"id": 1.0, "price": 2.5, "Tags": []interface{}{"a"}}
+
+	Specify("of different types", func() {
+		gomega.Expect(Equal(New(typed), New(decoded))).Should(gomega.BeFalse())
+		gomega.Expect(Equal(New(typed), New(decoded), EqualLooseNumbers())).Should(gomega.BeTrue())
+		gomega.Expect(Equal(New(&typed), New(map[string]interface{}{"id": 1, "price": 2.5}), EqualLooseNumbers())).Should(gomega.BeFalse())
+	})
+	Specify("of numbers", func() {
+		gomega.Expect(Equal(New(1), New(1))).Should(gomega.BeTrue())
+		gomega.Expect(Equal(New(1), New(int64(1)))).Should(gomega.BeFalse())
+		gomega.Expect(Equal(New(1), New(uint8(1)), EqualLooseNumbers())).Should(gomega.BeTrue())
+		x, y := 0.1, 0.2
+		gomega.Expect(Equal(New(x+y), New(0.3))).Should(gomega.BeFalse())
+		gomega.Expect(Equal(New(x+y), New(float32(0.3)), EqualTolerance(1e-6))).Should(gomega.BeTrue())
+		gomega.Expect(Equal(New(map[int]int{1: 1}), New(map[float64]int{1: 1}))).Should(gomega.BeTrue())
+	})
+	Specify("of nil and empty", func() {
+		var s []int
+		gomega.Expect(Equal(New(s), New(nil))).Should(gomega.BeTrue())
+		gomega.Expect(Equal(New(s), New([]string{}))).Should(gomega.BeFalse())
+		gomega.Expect(Equal(New(s), New(map[string]int{}), EqualNilEmpty())).Should(gomega.BeTrue())
+		gomega.Expect(Equal(New(nil), New([]int{0}), EqualNilEmpty())).Should(gomega.BeFalse())
+		gomega.Expect(Equal(nil, New((*int)(nil)))).Should(gomega.BeTrue())
+	})
+	Specify("ignoring paths", func() {
+		a := map[string]interface{}{"rows": []interface{}{map[string]interface{}{"v": 1, "at": 1}}, "at": 1}
+		b := map[string]interface{}{"rows": []interface{}{map[string]interface{}{"v": 1, "at": 2}}}
+		gomega.Expect(Equal(New(a), New(b))).Should(gomega.BeFalse())
+		gomega.Expect(Equal(New(a), New(b), EqualIgnorePaths("at", "rows.*.at"))).Should(gomega.BeTrue())
+
+		l := map[string]interface{}{"l": []int{1, 2, 3}}
+		gomega.Expect(Equal(New(l), New(map[string]interface{}{"l": []int{1, 5, 3}}), EqualIgnorePaths("l.1"))).Should(gomega.BeTrue())
+		gomega.Expect(Equal(New(l), New(map[string]interface{}{"l": []int{5, 2, 3}}), EqualIgnorePaths("l.1"))).Should(gomega.BeFalse())
+		gomega.Expect(Equal(New([]int{1, 2}), New([]int{1, 3}), EqualIgnorePaths("1"))).Should(gomega.BeTrue())
+		gomega.Expect(Equal(New(1), New("a"), EqualIgnorePaths(""))).Should(gomega.BeTrue())
+	})
+	Specify("of keys equal by value", func() {
+		a := map[interface{}]interface{}{1: "a", 1.0: "b"}
+		gomega.Expect(Equal(New(a), New(map[interface{}]interface{}{1.0: "b", 1: "a"}))).Should(gomega.BeTrue())
+		gomega.Expect(Equal(New(a), New(map[interface{}]interface{}{1: "a", 1.0: "c"}))).Should(gomega.BeFalse())
+		gomega.Expect(Equal(New(a), New(map[interface{}]interface{}{1: "a"}))).Should(gomega.BeFalse())
+		gomega.Expect(Equal(New(a), New(map[interface{}]interface{}{1: "a"}), EqualIgnorePaths("1"))).Should(gomega.BeTrue())
+	})
+	Specify("of cycles", func() {
+		a := map[string]interface{}{"v": 1}
+		a["self"] = a
+		b := map[string]interface{}{"v": 1}
+		b["self"] = b
+		gomega.Expect(Equal(New(a), New(b))).Should(gomega.BeTrue())
+	})
+})
+
+var _ = Describe("Compare", func() {
+	Specify("by kinds", func() {
+		vs := []interface{}{nil, false, -1, 2.5, uint(3), "a", []int{}, []int{1}, []int{1, 0}, map[string]int{}, struct{ A int }{1}}
+		for i := range vs {
+			for j := range vs {
+				gomega.Expect(Compare(New(vs[i]), New(vs[j]))).Should(gomega.Equal(compareInts(int64(i), int64(j))), "%v and %v", vs[i], vs[j])
+			}
+		}
+	})
+	Specify("of objects", func() {
+		gomega.Expect(Compare(New(map[string]int{"a": 1, "b": 2}), New(map[string]int{"a": 1, "b": 3}))).Should(gomega.Equal(-1))
+		gomega.Expect(Compare(New(map[string]int{"b": 1}), New(map[string]int{"a": 1, "b": 1}))).Should(gomega.Equal(1))
+		gomega.Expect(Compare(New(struct{ A, B int }{1, 2}), New(map[string]float64{"A": 1, "B": 2}))).Should(gomega.Equal(0))
+		gomega.Expect(Compare(New(complex(1, 1)), New(1))).Should(gomega.Equal(1))
+	})
+})
+
+var _ = Describe("Hash", func() {
+	hash := func(x interface{}) uint64 {
+		h, err := New(x).Hash()
+		gomega.ExpectWithOffset(1, err).Should(gomega.BeNil())
+		return h
+	}
+	Specify("of equal values", func() {
+		gomega.Expect(hash(1)).Should(gomega.Equal(hash(1.0)))
+		gomega.Expect(hash(uint8(1))).Should(gomega.Equal(hash(int64(1))))
+		gomega.Expect(hash(1)).ShouldNot(gomega.Equal(hash(1.5)))
+		gomega.Expect(hash("1")).ShouldNot(gomega.Equal(hash(1)))
+		gomega.Expect(hash(struct{ A, B int }{1, 2})).Should(gomega.Equal(hash(map[string]interface{}{"B": 2.0, "A": 1})))
+		gomega.Expect(hash([]int{1, 2})).Should(gomega.Equal(hash([2]float64{1, 2})))
+		gomega.Expect(hash([]int{1, 2})).ShouldNot(gomega.Equal(hash([]int{2, 1})))
+		gomega.Expect(hash([]interface{}{"a", ""})).ShouldNot(gomega.Equal(hash([]interface{}{"", "a"})))
+	})
+	Specify("stable", func() {
+		gomega.Expect(hash(map[string]interface{}{"a": []int{1}, "b": nil})).Should(gomega.Equal(uint64(0xdebc20008af82fa1)))
+	})
+	Specify("of keys equal by value", func() {
+		h := hash(map[interface{}]interface{}{1: "a", 1.0: "b"})
+		for i := 0; i < 50; i++ {
+			gomega.Expect(hash(map[interface{}]interface{}{1.0: "b", 1: "a"})).Should(gomega.Equal(h))
+		}
+		gomega.Expect(hash(map[interface{}]interface{}{1: "b", 1.0: "a"})).ShouldNot(gomega.Equal(h))
+	})
+	Specify("of cycles", func() {
+		a := map[string]interface{}{}
+		a["self"] = a
+		gomega.Expect(hash(a)).ShouldNot(gomega.BeZero())
+	})
+	Specify("of functions", func() {
+		ExpectErr(New(func() {}).Hash()).Should(gomega.Equal(&ErrUnsupportedKind{"Table.Hash", reflect.Func}))
+	})
+})
@@ -2,6 +2,8 @@ package table
 
 import (
 	"reflect"
+	"strconv"
+	"strings"
 )
 
 type (
@@ -44,6 +46,47 @@ type (
 	ErrOutOfRange struct {
 		Method string
 	}
+
+	// ErrTimeout ...
+	ErrTimeout struct {
+		Method string
+	}
+
+	// ErrClosed ...
+	ErrClosed struct {
+		Method string
+	}
+
+	// ErrConflict ...
+	ErrConflict struct {
+		Method string
+		Thing  string
+	}
+
+	// ErrPath ...
+	ErrPath struct {
+		Path Path
+		Err  error
+	}
+
+	// ErrMulti ...
+	ErrMulti struct {
+		Method string
+		Errs   []error
+	}
+
+	// ErrSyntax ...
+	ErrSyntax struct {
+		Method string
+		Pos    int
+		Msg    string
+	}
+
+	// ErrEval ...
+	ErrEval struct {
+		Pos int
+		Err error
+	}
 )
 
 func (e *ErrUnsupportedKind) Error() string {
@@ -85,3 +128,50 @@ func (e *ErrTypeUnequal) Error() string {
 func (e *ErrOutOfRange) Error() string {
 	return "table: call of " + e.Method + " out of range"
 }
+
+func (e *ErrTimeout) Error() string {
+	return "table: call of " + e.Method + " timed out"
+}
+
+func (e *ErrClosed) Error() string {
+	return "table: call of " + e.Method + " on closed channel"
+}
+
+func (e *ErrMulti) Error() string {
+	ss := make([]string, len(e.Errs))
+	for i, err := range e.Errs {
+		ss[i] = err.Error()
+	}
+	return "table: call of " + e.Method + " failed: " + strings.Join(ss, "; ")
+}
+
+// Unwrap returns the errors of e.
+func (e *ErrMulti) Unwrap() []error {
+	return e.Errs
+}
+
+func (e *ErrPath) Error() string {
+	return "table: at " + e.Path.String() + ": " + strings.TrimPrefix(e.Err.Error(), "table: ")
+}
+
+// Unwrap returns the error of e.
+func (e *ErrPath) Unwrap() error {
+	return e.Err
+}
+
+func (e *ErrConflict) Error() string {
+	return "table: call of " + e.Method + " conflicts on " + e.Thing
+}
+
+func (e *ErrSyntax) Error() string {
+	return "table: call of " + e.Method + " syntax error at " + strconv.Itoa(e.Pos) + ": " + e.Msg
+}
+
+func (e *ErrEval) Error() string {
+	return "table: eval at " + strconv.Itoa(e.Pos) + ": " + strings.TrimPrefix(e.Err.Error(), "table: ")
+}
+
+// Unwrap returns the error of e.
+func (e *ErrEval) Unwrap() error {
+	return e.Err
+}
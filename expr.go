@@ -0,0 +1,364 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Program is a compiled expression, see Compile.
+type Program struct {
+	src  string
+	root exprNode
+}
+
+// Compile compiles the expression expr to a Program evaluated by Eval.
+//
+// Expressions are side-effect-free, and they have
+//
+//	literals:    1, 2.5, "str" or `str`, true, false, nil, [a, b, ...]
+//	paths:       name, x.name, x[k]
+//	arithmetic:  + - * / %, and + of strings concatenates them
+//	comparison:  == != < <= > >=
+//	logical:     && || ! and c ? a : b
+//	membership:  x in y, y is a string, array, slice or map
+//	functions:   len, lower, upper, trim, contains, startsWith, endsWith, matches
+//
+// A name is got from the Table evaluated, and x.name and x[k] from the value x,
+// as of Get, a missing value is nil. Integers are computed as int64, and "/"
+// of them or others as float64. Values are equal as of ContainsValue, ordered
+// as of SortBy, but only numbers or strings can be ordered.
+// The error of a bad expr is an ErrSyntax of the position in expr.
+func Compile(expr string) (*Program, error) {
+	root, err := parseExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &Program{src: expr, root: root}, nil
+}
+
+// MustCompile must api for Compile
+func MustCompile(expr string) *Program {
+	p, err := Compile(expr)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the source expression of p.
+func (p *Program) String() string {
+	return p.src
+}
+
+// Eval evaluates p against t, and returns a Table of the result.
+// The error of evaluating is an ErrEval of the position in the expression.
+func (p *Program) Eval(t *Table) (*Table, error) {
+	if err := t.check("Program.Eval"); err != nil {
+		return nil, err
+	}
+	v, err := evalExpr(p.root, t)
+	if err != nil {
+		return nil, err
+	}
+	return t.child(reflect.ValueOf(v)), nil
+}
+
+// EvalBool evaluates p against t, and returns the result as a bool.
+func (p *Program) EvalBool(t *Table) (bool, error) {
+	r, err := p.Eval(t)
+	if err != nil {
+		return false, err
+	}
+	return r.Bool()
+}
+
+// exprErr returns err at pos as an ErrEval.
+func exprErr(pos int, err error) error {
+	if _, ok := err.(*ErrEval); ok || err == nil {
+		return err
+	}
+	return &ErrEval{pos, err}
+}
+
+func evalExpr(x exprNode, root *Table) (interface{}, error) {
+	switch x := x.(type) {
+	case *exprLit:
+		return x.v, nil
+
+	case *exprIdent:
+		v, err := exprGet(root.Interface(), x.name)
+		return v, exprErr(x.pos, err)
+
+	case *exprMember:
+		v, err := evalExpr(x.x, root)
+		if err != nil {
+			return nil, err
+		}
+		v, err = exprGet(v, x.name)
+		return v, exprErr(x.pos, err)
+
+	case *exprIndex:
+		v, err := evalExpr(x.x, root)
+		if err != nil {
+			return nil, err
+		}
+		k, err := evalExpr(x.index, root)
+		if err != nil {
+			return nil, err
+		}
+		v, err = exprGet(v, k)
+		return v, exprErr(x.pos, err)
+
+	case *exprList:
+		l := make([]interface{}, len(x.items))
+		for i, item := range x.items {
+			v, err := evalExpr(item, root)
+			if err != nil {
+				return nil, err
+			}
+			l[i] = v
+		}
+		return l, nil
+
+	case *exprUnary:
+		v, err := evalExpr(x.x, root)
+		if err != nil {
+			return nil, err
+		}
+		if x.op == "!" {
+			b, err := exprBool(v)
+			return !b, exprErr(x.pos, err)
+		}
+		v, err = arith("Program.Eval", "-", 0, v)
+		return v, exprErr(x.pos, err)
+
+	case *exprBinary:
+		v, err := evalBinary(x, root)
+		return v, exprErr(x.pos, err)
+
+	case *exprCond:
+		c, err := evalExpr(x.c, root)
+		if err != nil {
+			return nil, err
+		}
+		b, err := exprBool(c)
+		if err != nil {
+			return nil, exprErr(x.pos, err)
+		}
+		if b {
+			return evalExpr(x.a, root)
+		}
+		return evalExpr(x.b, root)
+
+	case *exprCall:
+		args := make([]interface{}, len(x.args))
+		for i, arg := range x.args {
+			v, err := evalExpr(arg, root)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = v
+		}
+		v, err := exprCallFunc(x.name, args)
+		return v, exprErr(x.pos, err)
+
+	default:
+		panic(fmt.Sprintf("table: unknown expression %T", x))
+	}
+}
+
+func evalBinary(x *exprBinary, root *Table) (interface{}, error) {
+	l, err := evalExpr(x.l, root)
+	if err != nil {
+		return nil, err
+	}
+
+	if x.op == "&&" || x.op == "||" {
+		lb, err := exprBool(l)
+		if err != nil || lb == (x.op == "||") { // short circuit
+			return lb, err
+		}
+		r, err := evalExpr(x.r, root)
+		if err != nil {
+			return nil, err
+		}
+		return exprBool(r)
+	}
+
+	r, err := evalExpr(x.r, root)
+	if err != nil {
+		return nil, err
+	}
+	lv, rv := indirect(reflect.ValueOf(l)), indirect(reflect.ValueOf(r))
+
+	switch x.op {
+	case "==":
+		return valueEqual(lv, rv), nil
+	case "!=":
+		return !valueEqual(lv, rv), nil
+	case "<", "<=", ">", ">=":
+		lr, rr := orderRank(lv), orderRank(rv)
+		if lr != rr || (lr != rankNumber && lr != rankString) {
+			return nil, &ErrTypeUnequal{"Program.Eval", lv.Kind(), rv.Kind()}
+		}
+		c := compareValues(lv, rv)
+		switch x.op {
+		case "<":
+			return c < 0, nil
+		case "<=":
+			return c <= 0, nil
+		case ">":
+			return c > 0, nil
+		default:
+			return c >= 0, nil
+		}
+	case "in":
+		return exprIn(lv, rv)
+	case "+":
+		if lv.Kind() == reflect.String && rv.Kind() == reflect.String {
+			return lv.String() + rv.String(), nil
+		}
+	}
+	return arith("Program.Eval", x.op, exprValue(lv), exprValue(rv))
+}
+
+// exprIn reports whether x is in y, a substring of a string, an element
+// of an array or slice, or a key of a map.
+func exprIn(x, y reflect.Value) (bool, error) {
+	switch y.Kind() {
+	case reflect.Invalid:
+		return false, nil
+	case reflect.String:
+		if x.Kind() != reflect.String {
+			return false, &ErrTypeUnequal{"Program.Eval", x.Kind(), y.Kind()}
+		}
+		return strings.Contains(y.String(), x.String()), nil
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < y.Len(); i++ {
+			if valueEqual(x, y.Index(i)) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case reflect.Map:
+		k, ok := convKey(exprValue(x), y.Type().Key())
+		return ok && y.MapIndex(k).IsValid(), nil
+	default:
+		return false, &ErrUnsupportedKind{"Program.Eval", y.Kind()}
+	}
+}
+
+// exprGet returns the value of the key k of x as of Get,
+// or the nil if it's not found.
+func exprGet(x interface{}, k interface{}) (interface{}, error) {
+	v := indirect(reflect.ValueOf(x))
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil, nil
+	case reflect.Map:
+		kv, ok := convKey(k, v.Type().Key())
+		if !ok {
+			return nil, nil
+		}
+		k = kv.Interface()
+	case reflect.Array, reflect.Slice:
+		i, ok := exprInt(k)
+		if !ok {
+			return nil, &ErrUnsupportedKind{"Program.Eval", reflect.ValueOf(k).Kind()}
+		}
+		if i < 0 {
+			return nil, nil
+		}
+		k = i
+	case reflect.Struct:
+		if _, ok := k.(string); !ok {
+			return nil, &ErrUnsupportedKind{"Program.Eval", reflect.ValueOf(k).Kind()}
+		}
+	default:
+		return nil, &ErrUnsupportedKind{"Program.Eval", v.Kind()}
+	}
+
+	r, err := (&Table{v: v}).Get(k)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	return exprValue(indirect(r.getv())), nil
+}
+
+// exprValue returns v as a value of expressions, the nil if it's invalid
+// or can't be interfaced.
+func exprValue(v reflect.Value) interface{} {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// exprInt returns the integral number k as an int.
+func exprInt(k interface{}) (int, bool) {
+	kv := reflect.ValueOf(k)
+	if orderRank(kv) != rankNumber {
+		return 0, false
+	}
+	iv, ok := convKey(k, reflect.TypeOf(0))
+	if !ok {
+		return 0, false
+	}
+	return int(iv.Int()), true
+}
+
+func exprBool(v interface{}) (bool, error) {
+	b, ok := v.(bool)
+	if !ok {
+		return false, &ErrTypeUnequal{"Program.Eval", reflect.Bool, reflect.ValueOf(v).Kind()}
+	}
+	return b, nil
+}
+
+func exprCallFunc(name string, args []interface{}) (interface{}, error) {
+	if name == "len" {
+		switch v := args[0].(type) {
+		case nil:
+			return 0, nil
+		case string:
+			return utf8.RuneCountInString(v), nil
+		}
+		if n := New(args[0]).Len(); n >= 0 {
+			return n, nil
+		}
+		return nil, &ErrUnsupportedKind{"Program.Eval", reflect.ValueOf(args[0]).Kind()}
+	}
+
+	ss := make([]string, len(args))
+	for i, arg := range args {
+		s, ok := arg.(string)
+		if !ok {
+			return nil, &ErrTypeUnequal{"Program.Eval", reflect.String, reflect.ValueOf(arg).Kind()}
+		}
+		ss[i] = s
+	}
+
+	switch name {
+	case "lower":
+		return strings.ToLower(ss[0]), nil
+	case "upper":
+		return strings.ToUpper(ss[0]), nil
+	case "trim":
+		return strings.TrimSpace(ss[0]), nil
+	case "contains":
+		return strings.Contains(ss[0], ss[1]), nil
+	case "startsWith":
+		return strings.HasPrefix(ss[0], ss[1]), nil
+	case "endsWith":
+		return strings.HasSuffix(ss[0], ss[1]), nil
+	default: // matches
+		re, err := regexp.Compile(ss[1])
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString(ss[0]), nil
+	}
+}
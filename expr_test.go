@@ -0,0 +1,91 @@
+package table
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Compile", func() {
+	type user struct {
+		Name   string
+		Age    *int
+		Groups []string
+	}
+	age := 20
+	req := New(map[string]interface{}{
+		"user":  user{"Ann", &age, []string{"alpha", "beta"}},
+		"attrs": map[int]string{1: "one"},
+		"n":     uint8(3),
+	})
+
+	eval := func(expr string) interface{} {
+		r, err := MustCompile(expr).Eval(req)
+		gomega.ExpectWithOffset(1, err).Should(gomega.BeNil())
+		return r.Interface()
+	}
+
+	Specify("predicates", func() {
+		gomega.Expect(MustCompile(`user.Age >= 18 && "beta" in user.Groups`).EvalBool(req)).Should(gomega.BeTrue())
+		gomega.Expect(eval(`user.Age < 18 || !("gamma" in user.Groups)`)).Should(gomega.Equal(true))
+		gomega.Expect(eval(`"nn" in user.Name && !("x" in user.Name)`)).Should(gomega.Equal(true))
+		gomega.Expect(eval(`1 in attrs && "1" in attrs && !(2 in attrs)`)).Should(gomega.Equal(true))
+		gomega.Expect(eval(`n in [1, 2, 3.0]`)).Should(gomega.Equal(true))
+		gomega.Expect(eval(`missing == nil && user.Missing == nil`)).Should(gomega.Equal(true))
+	})
+	Specify("arithmetic", func() {
+		gomega.Expect(eval(`n * 2 + user.Age % 7`)).Should(gomega.Equal(int64(12)))
+		gomega.Expect(eval(`-n / 2`)).Should(gomega.Equal(-1.5))
+		gomega.Expect(eval(`1 + 2 * 3 - 4`)).Should(gomega.Equal(int64(3)))
+		gomega.Expect(eval(`(1 + 2) * 3`)).Should(gomega.Equal(int64(9)))
+		gomega.Expect(eval(`user.Name + "!"`)).Should(gomega.Equal("Ann!"))
+	})
+	Specify("paths and functions", func() {
+		gomega.Expect(eval(`user.Groups[1]`)).Should(gomega.Equal("beta"))
+		gomega.Expect(eval(`user.Groups[n - 2]`)).Should(gomega.Equal("beta"))
+		gomega.Expect(eval(`user.Groups[5]`)).Should(gomega.BeNil())
+		gomega.Expect(eval(`attrs[1]`)).Should(gomega.Equal("one"))
+		gomega.Expect(eval(`upper(user.Name) + lower("X") + trim(" y ")`)).Should(gomega.Equal("ANNxy"))
+		gomega.Expect(eval(`len(user.Groups) == 2 && len("日本") == 2`)).Should(gomega.Equal(true))
+		gomega.Expect(eval(`startsWith(user.Name, "A") && endsWith(user.Name, "n") && contains(user.Name, "nn")`)).Should(gomega.Equal(true))
+		gomega.Expect(eval(`matches(user.Groups[0], "^a.+a$") ? "yes" : "no"`)).Should(gomega.Equal("yes"))
+	})
+	Specify("non-ASCII names", func() {
+		r, err := MustCompile("名前 + \u00a0\"様\"").Eval(New(map[string]string{"名前": "太郎"}))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(r.Interface()).Should(gomega.Equal("太郎様"))
+	})
+
+	Context("errors", func() {
+		Specify("syntax", func() {
+			_, err := Compile(`user.Age >= `)
+			gomega.Expect(err).Should(gomega.Equal(&ErrSyntax{"table.Compile", 12, "expected expression, got end of expression"}))
+			_, err = Compile(`a && (b || c`)
+			gomega.Expect(err).Should(gomega.Equal(&ErrSyntax{"table.Compile", 12, "expected \")\", got end of expression"}))
+			_, err = Compile(`a # b`)
+			gomega.Expect(err).Should(gomega.Equal(&ErrSyntax{"table.Compile", 2, "unexpected '#'"}))
+			_, err = Compile(`a → b`)
+			gomega.Expect(err).Should(gomega.Equal(&ErrSyntax{"table.Compile", 2, "unexpected '→'"}))
+			_, err = Compile(`size(a)`)
+			gomega.Expect(err).Should(gomega.Equal(&ErrSyntax{"table.Compile", 0, "unknown function size"}))
+			_, err = Compile(`lower(a, b)`)
+			gomega.Expect(err).Should(gomega.Equal(&ErrSyntax{"table.Compile", 0, "wrong number of arguments to lower"}))
+			_, err = Compile(`"abc`)
+			gomega.Expect(err).Should(gomega.Equal(&ErrSyntax{"table.Compile", 0, "unterminated string"}))
+		})
+		Specify("eval", func() {
+			ExpectErr(MustCompile(`user.Name < 1`).Eval(req)).
+				Should(gomega.Equal(&ErrEval{10, &ErrTypeUnequal{"Program.Eval", reflect.String, reflect.Int}}))
+			ExpectErr(MustCompile(`n && true`).Eval(req)).
+				Should(gomega.Equal(&ErrEval{2, &ErrTypeUnequal{"Program.Eval", reflect.Bool, reflect.Uint8}}))
+			ExpectErr(MustCompile(`1 + user.Name.x`).Eval(req)).
+				Should(gomega.Equal(&ErrEval{14, &ErrUnsupportedKind{"Program.Eval", reflect.String}}))
+			ExpectErr(MustCompile(`1 / 0`).Eval(req)).
+				Should(gomega.Equal(&ErrEval{2, &ErrOutOfRange{"Program.Eval"}}))
+		})
+		Specify("MustCompile", func() {
+			gomega.Expect(func() { MustCompile(`(`) }).Should(gomega.Panic())
+		})
+	})
+})
@@ -0,0 +1,398 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type exprTokenKind int
+
+const (
+	exprTokEOF exprTokenKind = iota
+	exprTokIdent
+	exprTokNumber
+	exprTokString
+	exprTokOp
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+// exprOps are the operators, longer ones first.
+var exprOps = []string{
+	"&&", "||", "==", "!=", "<=", ">=",
+	"!", "<", ">", "+", "-", "*", "/", "%", "(", ")", "[", "]", ",", ".", "?", ":",
+}
+
+func isExprIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func lexExpr(s string) ([]exprToken, error) {
+	var toks []exprToken
+	i := 0
+	for {
+		i = scanRunes(s, i, unicode.IsSpace)
+		if i >= len(s) {
+			return append(toks, exprToken{exprTokEOF, "", i}), nil
+		}
+
+		start := i
+		c := s[i]
+		r, _ := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+			i = scanRunes(s, i, isExprIdentRune)
+			toks = append(toks, exprToken{exprTokIdent, s[start:i], start})
+
+		case c >= '0' && c <= '9':
+			for i < len(s) && unicode.IsDigit(rune(s[i])) {
+				i++
+			}
+			if i+1 < len(s) && s[i] == '.' && unicode.IsDigit(rune(s[i+1])) {
+				i++
+				for i < len(s) && unicode.IsDigit(rune(s[i])) {
+					i++
+				}
+			}
+			if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+				i++
+				if i < len(s) && (s[i] == '+' || s[i] == '-') {
+					i++
+				}
+				for i < len(s) && unicode.IsDigit(rune(s[i])) {
+					i++
+				}
+			}
+			toks = append(toks, exprToken{exprTokNumber, s[start:i], start})
+
+		case c == '"' || c == '`':
+			i++
+			for i < len(s) && s[i] != c {
+				if c == '"' && s[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(s) {
+				return nil, &ErrSyntax{"table.Compile", start, "unterminated string"}
+			}
+			i++
+			str, err := strconv.Unquote(s[start:i])
+			if err != nil {
+				return nil, &ErrSyntax{"table.Compile", start, "invalid string " + s[start:i]}
+			}
+			toks = append(toks, exprToken{exprTokString, str, start})
+
+		default:
+			op := ""
+			for _, o := range exprOps {
+				if strings.HasPrefix(s[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, &ErrSyntax{"table.Compile", start, "unexpected " + strconv.QuoteRune(r)}
+			}
+			i += len(op)
+			toks = append(toks, exprToken{exprTokOp, op, start})
+		}
+	}
+}
+
+// expression nodes, pos is of the operator, name or literal.
+type (
+	exprNode interface{}
+
+	exprLit struct {
+		pos int
+		v   interface{}
+	}
+
+	// exprIdent is a value of the root Table.
+	exprIdent struct {
+		pos  int
+		name string
+	}
+
+	exprMember struct {
+		pos  int
+		x    exprNode
+		name string
+	}
+
+	exprIndex struct {
+		pos      int
+		x, index exprNode
+	}
+
+	exprList struct {
+		pos   int
+		items []exprNode
+	}
+
+	exprUnary struct {
+		pos int
+		op  string
+		x   exprNode
+	}
+
+	exprBinary struct {
+		pos  int
+		op   string
+		l, r exprNode
+	}
+
+	exprCond struct {
+		pos     int
+		c, a, b exprNode
+	}
+
+	exprCall struct {
+		pos  int
+		name string
+		args []exprNode
+	}
+)
+
+// exprFuncs are the functions and the numbers of their arguments.
+var exprFuncs = map[string]int{
+	"len": 1, "lower": 1, "upper": 1, "trim": 1,
+	"contains": 2, "startsWith": 2, "endsWith": 2, "matches": 2,
+}
+
+type exprParser struct {
+	toks []exprToken
+	i    int
+}
+
+func parseExpr(s string) (exprNode, error) {
+	toks, err := lexExpr(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{toks: toks}
+	x, err := p.parseCond()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != exprTokEOF {
+		return nil, p.errorf(tok, "expected end of expression")
+	}
+	return x, nil
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.toks[p.i]
+}
+
+func (p *exprParser) next() exprToken {
+	tok := p.toks[p.i]
+	if tok.kind != exprTokEOF {
+		p.i++
+	}
+	return tok
+}
+
+func (p *exprParser) errorf(tok exprToken, msg string) error {
+	if tok.kind == exprTokEOF {
+		return &ErrSyntax{"table.Compile", tok.pos, msg + ", got end of expression"}
+	}
+	return &ErrSyntax{"table.Compile", tok.pos, msg + ", got " + strconv.Quote(tok.text)}
+}
+
+// acceptOp returns the token of the first of ops next, or false.
+func (p *exprParser) acceptOp(ops ...string) (exprToken, bool) {
+	tok := p.peek()
+	if tok.kind == exprTokOp || (tok.kind == exprTokIdent && tok.text == "in") {
+		for _, op := range ops {
+			if tok.text == op {
+				return p.next(), true
+			}
+		}
+	}
+	return tok, false
+}
+
+func (p *exprParser) expectOp(op string) error {
+	if tok, ok := p.acceptOp(op); !ok {
+		return p.errorf(tok, "expected "+strconv.Quote(op))
+	}
+	return nil
+}
+
+func (p *exprParser) parseCond() (exprNode, error) {
+	c, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	tok, ok := p.acceptOp("?")
+	if !ok {
+		return c, nil
+	}
+	a, err := p.parseCond()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectOp(":"); err != nil {
+		return nil, err
+	}
+	b, err := p.parseCond()
+	return &exprCond{tok.pos, c, a, b}, err
+}
+
+// exprLevels are the binary operators from the lowest precedence.
+var exprLevels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">=", "in"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) parseBinary(level int) (exprNode, error) {
+	if level == len(exprLevels) {
+		return p.parseUnary()
+	}
+	l, err := p.parseBinary(level + 1)
+	for err == nil {
+		tok, ok := p.acceptOp(exprLevels[level]...)
+		if !ok {
+			break
+		}
+		var r exprNode
+		if r, err = p.parseBinary(level + 1); err == nil {
+			l = &exprBinary{tok.pos, tok.text, l, r}
+		}
+	}
+	return l, err
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if tok, ok := p.acceptOp("!", "-"); ok {
+		x, err := p.parseUnary()
+		return &exprUnary{tok.pos, tok.text, x}, err
+	}
+	return p.parsePostfix()
+}
+
+func (p *exprParser) parsePostfix() (exprNode, error) {
+	x, err := p.parsePrimary()
+	for err == nil {
+		tok, ok := p.acceptOp(".", "[")
+		if !ok {
+			break
+		}
+		if tok.text == "." {
+			name := p.next()
+			if name.kind != exprTokIdent {
+				return nil, p.errorf(name, "expected name")
+			}
+			x = &exprMember{name.pos, x, name.text}
+			continue
+		}
+		var index exprNode
+		if index, err = p.parseCond(); err == nil {
+			x = &exprIndex{tok.pos, x, index}
+			err = p.expectOp("]")
+		}
+	}
+	return x, err
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case exprTokNumber:
+		if i, err := strconv.Atoi(tok.text); err == nil {
+			return &exprLit{tok.pos, i}, nil
+		}
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf(tok, "expected number")
+		}
+		return &exprLit{tok.pos, f}, nil
+
+	case exprTokString:
+		return &exprLit{tok.pos, tok.text}, nil
+
+	case exprTokIdent:
+		switch tok.text {
+		case "true":
+			return &exprLit{tok.pos, true}, nil
+		case "false":
+			return &exprLit{tok.pos, false}, nil
+		case "nil", "null":
+			return &exprLit{tok.pos, nil}, nil
+		case "in":
+			return nil, p.errorf(tok, "expected expression")
+		}
+		if _, ok := p.acceptOp("("); ok {
+			return p.parseCall(tok)
+		}
+		return &exprIdent{tok.pos, tok.text}, nil
+
+	case exprTokOp:
+		switch tok.text {
+		case "(":
+			x, err := p.parseCond()
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expectOp(")")
+		case "[":
+			l := &exprList{pos: tok.pos}
+			if _, ok := p.acceptOp("]"); ok {
+				return l, nil
+			}
+			for {
+				x, err := p.parseCond()
+				if err != nil {
+					return nil, err
+				}
+				l.items = append(l.items, x)
+				if _, ok := p.acceptOp(","); !ok {
+					break
+				}
+			}
+			return l, p.expectOp("]")
+		}
+	}
+	return nil, p.errorf(tok, "expected expression")
+}
+
+func (p *exprParser) parseCall(name exprToken) (exprNode, error) {
+	n, ok := exprFuncs[name.text]
+	if !ok {
+		return nil, &ErrSyntax{"table.Compile", name.pos, "unknown function " + name.text}
+	}
+
+	call := &exprCall{pos: name.pos, name: name.text}
+	if _, ok := p.acceptOp(")"); !ok {
+		for {
+			x, err := p.parseCond()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, x)
+			if _, ok := p.acceptOp(","); !ok {
+				break
+			}
+		}
+		if err := p.expectOp(")"); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(call.args) != n {
+		return nil, &ErrSyntax{"table.Compile", name.pos, "wrong number of arguments to " + name.text}
+	}
+	return call, nil
+}
@@ -0,0 +1,249 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FlattenOption configures Flatten.
+type FlattenOption func(*flattenOptions)
+
+type flattenOptions struct {
+	brackets  bool
+	maxDepth  int
+	keepEmpty bool
+}
+
+// FlattenBrackets writes indexes of arrays and slices as "a[0]" instead of "a.0".
+func FlattenBrackets() FlattenOption {
+	return func(o *flattenOptions) {
+		o.brackets = true
+	}
+}
+
+// FlattenMaxDepth flattens at most depth keys deep,
+// deeper containers are kept as values.
+func FlattenMaxDepth(depth int) FlattenOption {
+	return func(o *flattenOptions) {
+		o.maxDepth = depth
+	}
+}
+
+// FlattenKeepEmpty keeps empty maps, arrays and slices as values,
+// by default they are dropped.
+func FlattenKeepEmpty() FlattenOption {
+	return func(o *flattenOptions) {
+		o.keepEmpty = true
+	}
+}
+
+// Flatten returns t's underlying value flattened to a map of keys joined by sep,
+// e.g. {"db": {"hosts": ["a"]}} to {"db.hosts.0": "a"}.
+//
+// Map keys are in their String() forms, struct fields are named by their
+// table tags or field names, fields tagged "_" and unexported fields are
+// passed. A scalar t is flattened to the key "".
+func (t *Table) Flatten(sep string, opts ...FlattenOption) (map[string]interface{}, error) {
+	if err := t.check("Table.Flatten"); err != nil {
+		return nil, err
+	}
+
+	f := &flattener{
+		opts: flattenOptions{maxDepth: -1},
+		sep:  sep,
+		m:    map[string]interface{}{},
+		seen: map[walkPtr]bool{},
+	}
+	for _, opt := range opts {
+		opt(&f.opts)
+	}
+	f.flatten("", 0, t.getv())
+	return f.m, nil
+}
+
+type flattener struct {
+	opts flattenOptions
+	sep  string
+	m    map[string]interface{}
+	seen map[walkPtr]bool
+}
+
+func (f *flattener) flatten(key string, depth int, v reflect.Value) {
+	w := &walker{seen: f.seen}
+	iv, ptrs, cyclic := w.indirect(v)
+	defer func() {
+		for _, p := range ptrs {
+			delete(f.seen, p)
+		}
+	}()
+
+	if cyclic || (f.opts.maxDepth >= 0 && depth >= f.opts.maxDepth) {
+		f.put(key, v)
+		return
+	}
+
+	n := 0
+	switch iv.Kind() {
+	case reflect.Map:
+		for _, k := range sortedMapKeys(iv, nil) {
+			f.flatten(f.join(key, fmt.Sprint(k), false), depth+1, iv.MapIndex(k))
+			n++
+		}
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < iv.Len(); i++ {
+			f.flatten(f.join(key, strconv.Itoa(i), f.opts.brackets), depth+1, iv.Index(i))
+			n++
+		}
+	case reflect.Struct:
+		it := iv.Type()
+		for i := 0; i < it.NumField(); i++ {
+			sf := it.Field(i)
+			name := sf.Tag.Get("table")
+			if sf.PkgPath != "" || name == "_" {
+				continue
+			}
+			if name == "" {
+				name = sf.Name
+			}
+			f.flatten(f.join(key, name, false), depth+1, iv.Field(i))
+			n++
+		}
+	default:
+		f.put(key, v)
+		return
+	}
+
+	if n == 0 && f.opts.keepEmpty {
+		f.put(key, v)
+	}
+}
+
+func (f *flattener) join(key, k string, bracket bool) string {
+	switch {
+	case bracket:
+		return key + "[" + k + "]"
+	case key == "":
+		return k
+	default:
+		return key + f.sep + k
+	}
+}
+
+func (f *flattener) put(key string, v reflect.Value) {
+	if !v.IsValid() || !v.CanInterface() {
+		f.m[key] = nil
+		return
+	}
+	f.m[key] = v.Interface()
+}
+
+// Unflatten returns a Table of the nested value of the flattened m,
+// it's the reverse of Flatten with keys joined by sep.
+//
+// Keys are split by sep and by brackets like "a[0]". A level whose keys are
+// all the dense indexes from 0 becomes a []interface{}, other levels become
+// map[string]interface{}.
+// It returns ErrConflict if a key is both a value and a level, e.g. "a" and "a.b".
+func Unflatten(m map[string]interface{}, sep string) (*Table, error) {
+	if v, ok := m[""]; ok {
+		if len(m) > 1 {
+			return nil, &ErrConflict{"table.Unflatten", `"" key`}
+		}
+		return New(v), nil
+	}
+
+	root := map[string]interface{}{}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		ks := splitFlatKey(key, sep)
+		level := root
+		for i, k := range ks[:len(ks)-1] {
+			next, ok := level[k]
+			if !ok {
+				next = map[string]interface{}{}
+				level[k] = next
+			}
+			nl, ok := next.(map[string]interface{})
+			if !ok {
+				return nil, &ErrConflict{"table.Unflatten", strings.Join(ks[:i+1], sep) + " key"}
+			}
+			level = nl
+		}
+
+		last := ks[len(ks)-1]
+		if _, ok := level[last]; ok {
+			return nil, &ErrConflict{"table.Unflatten", key + " key"}
+		}
+		level[last] = flatLeaf{m[key]}
+	}
+	return New(unflattenLevel(root)), nil
+}
+
+// flatLeaf marks values of Unflatten apart from its levels.
+type flatLeaf struct {
+	v interface{}
+}
+
+// unflattenLevel returns the level x with leaves unmarked,
+// and levels of dense indexes as slices.
+func unflattenLevel(x interface{}) interface{} {
+	switch l := x.(type) {
+	case flatLeaf:
+		return l.v
+	case map[string]interface{}:
+		if s, ok := denseLevel(l); ok {
+			return s
+		}
+		for k, v := range l {
+			l[k] = unflattenLevel(v)
+		}
+		return l
+	default:
+		return x
+	}
+}
+
+func denseLevel(l map[string]interface{}) ([]interface{}, bool) {
+	s := make([]interface{}, len(l))
+	for k, v := range l {
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 0 || i >= len(l) || strconv.Itoa(i) != k {
+			return nil, false
+		}
+		s[i] = v
+	}
+	for i, v := range s {
+		s[i] = unflattenLevel(v)
+	}
+	return s, len(s) > 0
+}
+
+// splitFlatKey splits key by sep and brackets, "a.b[0][1]" is "a", "b", "0", "1".
+func splitFlatKey(key, sep string) []string {
+	parts := []string{key}
+	if sep != "" {
+		parts = strings.Split(key, sep)
+	}
+
+	var ks []string
+	for _, p := range parts {
+		i := strings.IndexByte(p, '[')
+		if i < 0 || !strings.HasSuffix(p, "]") {
+			ks = append(ks, p)
+			continue
+		}
+		if i > 0 {
+			ks = append(ks, p[:i])
+		}
+		ks = append(ks, strings.Split(p[i+1:len(p)-1], "][")...)
+	}
+	return ks
+}
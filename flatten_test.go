@@ -0,0 +1,99 @@
+package table
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+type flatKey struct{ a, b int }
+
+func (k flatKey) String() string { return "k" }
+
+var _ = Describe("Flatten", func() {
+	x := map[string]interface{}{
+		"db": map[string]interface{}{
+			"hosts": []interface{}{"a", "b"},
+			"port":  1,
+		},
+		"empty": []int{},
+		"name":  "n",
+	}
+
+	Specify("with dotted keys", func() {
+		gomega.Expect(New(x).Flatten(".")).Should(gomega.Equal(map[string]interface{}{
+			"db.hosts.0": "a",
+			"db.hosts.1": "b",
+			"db.port":    1,
+			"name":       "n",
+		}))
+	})
+	Specify("with options", func() {
+		gomega.Expect(New(x).Flatten("_", FlattenBrackets(), FlattenKeepEmpty())).Should(gomega.Equal(map[string]interface{}{
+			"db_hosts[0]": "a",
+			"db_hosts[1]": "b",
+			"db_port":     1,
+			"empty":       []int{},
+			"name":        "n",
+		}))
+		gomega.Expect(New(x).Flatten(".", FlattenMaxDepth(1))).Should(gomega.Equal(map[string]interface{}{
+			"db":    x["db"],
+			"empty": []int{},
+			"name":  "n",
+		}))
+	})
+	Specify("of structs and non-string keys", func() {
+		type s struct {
+			A int `table:"a"`
+			B int `table:"_"`
+			C map[int]string
+			D map[flatKey]int
+			e int
+		}
+		y := s{A: 1, B: 2, C: map[int]string{3: "c"}, D: map[flatKey]int{{}: 4}}
+		gomega.Expect(New(&y).Flatten(".")).Should(gomega.Equal(map[string]interface{}{
+			"a":   1,
+			"C.3": "c",
+			"D.k": 4,
+		}))
+	})
+	Specify("of scalar", func() {
+		gomega.Expect(New(1).Flatten(".")).Should(gomega.Equal(map[string]interface{}{"": 1}))
+	})
+})
+
+var _ = Describe("Unflatten", func() {
+	Specify("round-trips", func() {
+		x := map[string]interface{}{
+			"db": map[string]interface{}{
+				"hosts": []interface{}{"a", map[string]interface{}{"b": 1}},
+				"port":  1,
+			},
+			"name": "n",
+		}
+		for _, opts := range [][]FlattenOption{nil, {FlattenBrackets()}} {
+			m, err := New(x).Flatten(".", opts...)
+			gomega.Expect(err).Should(gomega.BeNil())
+			t, err := Unflatten(m, ".")
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(t.Interface()).Should(gomega.Equal(x))
+		}
+	})
+	Specify("with sparse indexes", func() {
+		t, err := Unflatten(map[string]interface{}{"a.1": 1, "a[2]": 2}, ".")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(t.Interface()).Should(gomega.Equal(map[string]interface{}{
+			"a": map[string]interface{}{"1": 1, "2": 2},
+		}))
+	})
+	Specify("with conflicts", func() {
+		_, err := Unflatten(map[string]interface{}{"a": 1, "a.b": 2}, ".")
+		gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrConflict)(nil)))
+		_, err = Unflatten(map[string]interface{}{"": 1, "a": 2}, ".")
+		gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrConflict)(nil)))
+	})
+	Specify("of scalar", func() {
+		t, err := Unflatten(map[string]interface{}{"": 1}, ".")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(t.Interface()).Should(gomega.Equal(1))
+	})
+})
@@ -0,0 +1,300 @@
+package table
+
+import (
+	"reflect"
+)
+
+// Freeze returns an immutable Table of v.
+//
+// Put, Set, Insert, Delete, SortBy, Send, TrySend and Close of a frozen Table
+// return ErrCannotSet, Bytes of it returns a copy, and the Tables got from it
+// by Get, GetPath, At, EachDo, All or Walk are frozen too, as are the results of Map, Slice, AList, PList, Filter, MapValues,
+// SortedBy, GroupBy, Transform, SQL, Index lookups and Program.Eval on it, so
+// none of them modify the values shared. Its With, Without and Merge return new
+// frozen Tables sharing the unchanged values with it, so it's a cheap snapshot
+// for concurrent readers. An Index of it isn't kept by it, see Table.Index.
+// Freeze doesn't copy v, so v must not be modified later, or Freeze a Clone.
+func Freeze(v interface{}) *Table {
+	return &Table{i: v, v: reflect.ValueOf(v), frozen: true}
+}
+
+// Frozen reports whether t is frozen, see Freeze.
+func (t *Table) Frozen() bool {
+	return t != nil && t.frozen
+}
+
+// freeze returns a frozen copy of t without its indexes,
+// the nil *Table is kept.
+func (t *Table) freeze() *Table {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	c.indexes, c.frozen = nil, true
+	return &c
+}
+
+// checkSet returns the error of check, or ErrCannotSet if t is frozen.
+func (t *Table) checkSet(method string) error {
+	if err := t.check(method); err != nil {
+		return err
+	}
+	if t.frozen {
+		return &ErrCannotSet{method}
+	}
+	return nil
+}
+
+// With returns a frozen Table of t's underlying value with v at path,
+// the path is like GetPath's.
+//
+// Only the maps, arrays, slices, structs and pointers on the path are copied,
+// others are shared with t. A missing map key at the end of path is added,
+// other keys on the path must exist.
+// It returns ErrTypeUnequal if v is not assignable to the value at path.
+func (t *Table) With(path interface{}, v interface{}) (*Table, error) {
+	if err := t.check("Table.With"); err != nil {
+		return nil, err
+	}
+	nv, err := persistSet("Table.With", t.getv(), toPath(path), reflect.ValueOf(v), false)
+	if err != nil {
+		return nil, err
+	}
+	return frozenTable(nv), nil
+}
+
+// Without returns a frozen Table of t's underlying value without the value
+// at path, a map key is deleted and an element of a slice is removed.
+//
+// Only the maps, arrays, slices, structs and pointers on the path are copied,
+// others are shared with t. If path is not found, t's value is all shared.
+// It returns ErrUnsupportedKind if the value is of an array or a struct.
+func (t *Table) Without(path interface{}) (*Table, error) {
+	if err := t.check("Table.Without"); err != nil {
+		return nil, err
+	}
+	p := toPath(path)
+	x, err := t.GetPath(p)
+	if err != nil {
+		return nil, err
+	}
+	if x == nil {
+		return frozenTable(t.getv()), nil
+	}
+	nv, err := persistSet("Table.Without", t.getv(), p, reflect.Value{}, true)
+	if err != nil {
+		return nil, err
+	}
+	return frozenTable(nv), nil
+}
+
+// Merge returns a frozen Table of t's underlying map merged with o's, where
+// o's values replace t's ones of the same keys, but maps in both of them
+// are merged deeply.
+//
+// Only the maps merged are copied, others are shared with t and o.
+// It returns ErrUnsupportedKind if t's or o's value is not a map.
+func (t *Table) Merge(o *Table) (*Table, error) {
+	if err := t.check("Table.Merge"); err != nil {
+		return nil, err
+	}
+	if err := o.check("Table.Merge"); err != nil {
+		return nil, err
+	}
+	nv, err := persistMerge("Table.Merge", t.getv(), o.getv())
+	if err != nil {
+		return nil, err
+	}
+	return frozenTable(nv), nil
+}
+
+// frozenTable returns a frozen Table of v.
+func frozenTable(v reflect.Value) *Table {
+	t := &Table{v: v, frozen: true}
+	if v.IsValid() && v.CanInterface() {
+		t.i = v.Interface()
+	}
+	return t
+}
+
+// persistSet returns a copy of v with x at path, or without the value
+// at path if del, copying only the values on the path.
+func persistSet(method string, v reflect.Value, path Path, x reflect.Value, del bool) (reflect.Value, error) {
+	if len(path) == 0 {
+		return x, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Invalid:
+		return v, &ErrNotExist{method, "value at " + path.String()}
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, &ErrNotExist{method, "value at " + path.String()}
+		}
+		return persistSet(method, v.Elem(), path, x, del)
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v, &ErrNotExist{method, "value at " + path.String()}
+		}
+		ne, err := persistSet(method, v.Elem(), path, x, del)
+		if err != nil {
+			return v, err
+		}
+		np := reflect.New(v.Type().Elem())
+		np.Elem().Set(ne)
+		return np, nil
+	}
+
+	k, rest := path[0], path[1:]
+	leaf := len(rest) == 0
+	if !leaf {
+		var child reflect.Value
+		var ok bool
+		switch v.Kind() {
+		case reflect.Map:
+			child, ok = mapLookup(v, k)
+		case reflect.Array, reflect.Slice:
+			child, ok = sliceLookup(v, k)
+		case reflect.Struct:
+			child, ok = structLookup(v, k)
+		default:
+			return v, &ErrUnsupportedKind{method, v.Kind()}
+		}
+		if !ok {
+			return v, &ErrNotExist{method, "value at " + path.String()}
+		}
+
+		nx, err := persistSet(method, child, rest, x, del)
+		if err != nil {
+			return v, err
+		}
+		x = nx
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		kv, ok := convKey(k, v.Type().Key())
+		if !ok {
+			return v, &ErrTypeUnequal{method, v.Type().Key().Kind(), reflect.ValueOf(k).Kind()}
+		}
+		nm := reflect.MakeMapWithSize(v.Type(), v.Len()+1)
+		iter := v.MapRange()
+		for iter.Next() {
+			nm.SetMapIndex(iter.Key(), iter.Value())
+		}
+		if del && leaf {
+			nm.SetMapIndex(kv, reflect.Value{})
+			return nm, nil
+		}
+		nx, err := persistValue(method, x, v.Type().Elem())
+		if err != nil {
+			return v, err
+		}
+		nm.SetMapIndex(kv, nx)
+		return nm, nil
+
+	case reflect.Array, reflect.Slice:
+		idx, ok := sliceIndex(k)
+		if !ok || idx < 0 || idx >= v.Len() {
+			return v, &ErrOutOfRange{method}
+		}
+		if del && leaf {
+			if v.Kind() == reflect.Array {
+				return v, &ErrUnsupportedKind{method, v.Kind()}
+			}
+			l := v.Len()
+			ns := reflect.MakeSlice(v.Type(), l-1, l-1)
+			reflect.Copy(ns, v.Slice(0, idx))
+			reflect.Copy(ns.Slice(idx, l-1), v.Slice(idx+1, l))
+			return ns, nil
+		}
+		nx, err := persistValue(method, x, v.Type().Elem())
+		if err != nil {
+			return v, err
+		}
+		var ns reflect.Value
+		if v.Kind() == reflect.Array {
+			ns = reflect.New(v.Type()).Elem()
+			ns.Set(v)
+		} else {
+			ns = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+			reflect.Copy(ns, v)
+		}
+		ns.Index(idx).Set(nx)
+		return ns, nil
+
+	case reflect.Struct:
+		if del && leaf {
+			return v, &ErrUnsupportedKind{method, v.Kind()}
+		}
+		ns := reflect.New(v.Type()).Elem()
+		ns.Set(v)
+		f, ok := structLookup(ns, k)
+		if !ok {
+			return v, &ErrNotExist{method, "value at " + path.String()}
+		}
+		if !f.CanSet() {
+			return v, &ErrCannotSet{method}
+		}
+		nx, err := persistValue(method, x, f.Type())
+		if err != nil {
+			return v, err
+		}
+		f.Set(nx)
+		return ns, nil
+
+	default:
+		return v, &ErrUnsupportedKind{method, v.Kind()}
+	}
+}
+
+// persistMerge returns a copy of the map a merged with the map b.
+func persistMerge(method string, a, b reflect.Value) (reflect.Value, error) {
+	a, b = indirect(a), indirect(b)
+	if a.Kind() != reflect.Map {
+		return a, &ErrUnsupportedKind{method, a.Kind()}
+	}
+	if b.Kind() != reflect.Map {
+		return a, &ErrUnsupportedKind{method, b.Kind()}
+	}
+
+	nm := reflect.MakeMapWithSize(a.Type(), a.Len()+b.Len())
+	iter := a.MapRange()
+	for iter.Next() {
+		nm.SetMapIndex(iter.Key(), iter.Value())
+	}
+
+	iter = b.MapRange()
+	for iter.Next() {
+		k, ok := convKey(iter.Key().Interface(), a.Type().Key())
+		if !ok {
+			return a, &ErrTypeUnequal{method, a.Type().Key().Kind(), iter.Key().Kind()}
+		}
+
+		x := iter.Value()
+		if x.Kind() == reflect.Interface {
+			x = x.Elem()
+		}
+		if av := a.MapIndex(k); av.IsValid() && indirect(av).Kind() == reflect.Map && indirect(x).Kind() == reflect.Map {
+			var err error
+			if x, err = persistMerge(method, av, x); err != nil {
+				return a, err
+			}
+		}
+		nx, err := persistValue(method, x, a.Type().Elem())
+		if err != nil {
+			return a, err
+		}
+		nm.SetMapIndex(k, nx)
+	}
+	return nm, nil
+}
+
+// persistValue returns x to be set to a value of the type typ.
+func persistValue(method string, x reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	nx, ok := assignable(x, typ)
+	if !ok {
+		return x, &ErrTypeUnequal{method, typ.Kind(), x.Kind()}
+	}
+	return nx, nil
+}
@@ -0,0 +1,246 @@
+package table
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Freeze", func() {
+	type point struct {
+		X, Y int
+		z    int
+	}
+	var x map[string]interface{}
+	BeforeEach(func() {
+		x = map[string]interface{}{
+			"a": map[string]interface{}{"b": 1, "c": []int{1, 2}},
+			"d": []interface{}{"e", map[string]int{"f": 2}},
+			"p": &point{X: 1},
+		}
+	})
+
+	Specify("can't be set", func() {
+		t := Freeze(x)
+		gomega.Expect(t.Frozen()).Should(gomega.BeTrue())
+		gomega.Expect(New(x).Frozen()).Should(gomega.BeFalse())
+		gomega.Expect(t.Put("a", 1)).Should(gomega.Equal(&ErrCannotSet{"Table.Put"}))
+		gomega.Expect(t.MustGet("a").Put("b", 2)).Should(gomega.Equal(&ErrCannotSet{"Table.Put"}))
+		gomega.Expect(t.At("a").At("c").Delete(0)).Should(gomega.Equal(&ErrCannotSet{"Table.Delete"}))
+		gomega.Expect(t.MustGetPath("a.c").Insert(0, 0)).Should(gomega.Equal(&ErrCannotSet{"Table.Insert"}))
+		gomega.Expect(t.MustGetPath("a.c").SortBy("-")).Should(gomega.Equal(&ErrCannotSet{"Table.SortBy"}))
+		gomega.Expect(t.MustGetPath("p.X").Set(2)).Should(gomega.Equal(&ErrCannotSet{"Table.Set"}))
+		for _, v := range t.All() {
+			gomega.Expect(v.Frozen()).Should(gomega.BeTrue())
+		}
+		t.Walk(func(_ Path, v *Table) WalkAction {
+			gomega.Expect(v.Frozen()).Should(gomega.BeTrue())
+			return Continue
+		})
+		gomega.Expect(x["a"]).Should(gomega.Equal(map[string]interface{}{"b": 1, "c": []int{1, 2}}))
+	})
+	Specify("by every accessor", func() {
+		cannotSet := &ErrCannotSet{"Table.Put"}
+		rows := []map[string]interface{}{{"id": 1, "n": "a"}, {"id": 2, "n": "b"}}
+		t := Freeze(rows)
+
+		m := Freeze(x).MustMap()
+		for k, v := range m {
+			gomega.Expect(k.Frozen()).Should(gomega.BeTrue())
+			gomega.Expect(v.Frozen()).Should(gomega.BeTrue())
+		}
+		gomega.Expect(Freeze(&x).MustMap()).ShouldNot(gomega.BeEmpty())
+		for _, v := range Freeze(&x).MustMap() {
+			gomega.Expect(v.Frozen()).Should(gomega.BeTrue())
+		}
+		gomega.Expect(t.MustSlice()[0].Put("x", 99)).Should(gomega.Equal(cannotSet))
+		gomega.Expect(t.MustAList()[0][1].Put("x", 99)).Should(gomega.Equal(cannotSet))
+		gomega.Expect(t.MustPList()[1].Put("x", 99)).Should(gomega.Equal(cannotSet))
+
+		f, err := t.Filter(func(_, _ *Table) bool { return true })
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(f.MustGet(0).Put("x", 99)).Should(gomega.Equal(cannotSet))
+		mv, err := t.MapValues(func(_, v *Table) (interface{}, error) { return v.Interface(), nil })
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(mv.Frozen()).Should(gomega.BeTrue())
+		s, err := t.SortedBy("-id")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(s.MustGet(0).Put("x", 99)).Should(gomega.Equal(cannotSet))
+		g, err := t.GroupBy("n")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(g["a"].MustGet(0).Put("x", 99)).Should(gomega.Equal(cannotSet))
+		tr := t.Transform(func(_ Path, v *Table) (interface{}, bool) {
+			gomega.Expect(v.Frozen()).Should(gomega.BeTrue())
+			return nil, false
+		})
+		gomega.Expect(tr.Frozen()).Should(gomega.BeTrue())
+		q, err := t.SQL("SELECT id FROM .")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(q.Frozen()).Should(gomega.BeTrue())
+		e, err := MustCompile("[1]").Eval(t)
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(e.Frozen()).Should(gomega.BeTrue())
+
+		ix := t.Index("id", IndexUnique())
+		gomega.Expect(t.indexes).Should(gomega.BeEmpty())
+		r, err := ix.Lookup(1)
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(r.Put("x", 99)).Should(gomega.Equal(cannotSet))
+
+		gomega.Expect(rows[0]).ShouldNot(gomega.HaveKey("x"))
+	})
+	Specify("by concurrent readers", func() {
+		t := Freeze(x)
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				gomega.Expect(t.MustGetPath("a.b").MustInt()).Should(gomega.Equal(1))
+				gomega.Expect(t.At("d").At(0).MustString()).Should(gomega.Equal("e"))
+				gomega.Expect(t.Len()).Should(gomega.Equal(3))
+				gomega.Expect(t.MustMap()).Should(gomega.HaveLen(3))
+				gomega.Expect(t.MustWith("a.b", 2).MustGetPath("a.b").MustInt()).Should(gomega.Equal(2))
+				gomega.Expect(t.Interface()).ShouldNot(gomega.BeNil())
+			}()
+		}
+		wg.Wait()
+	})
+	Specify("of bytes and channels", func() {
+		b := []byte("ab")
+		t := Freeze(map[string]interface{}{"b": b})
+		c, err := t.MustGet("b").Bytes()
+		gomega.Expect(err).Should(gomega.BeNil())
+		c[0] = 'x'
+		gomega.Expect(b).Should(gomega.Equal([]byte("ab")))
+		gomega.Expect(Freeze(&b).Bytes()).Should(gomega.Equal([]byte("ab")))
+
+		ch := make(chan int, 1)
+		ct := Freeze(ch)
+		gomega.Expect(ct.Send(1)).Should(gomega.Equal(&ErrCannotSet{"Table.Send"}))
+		ExpectErr(ct.TrySend(1)).Should(gomega.Equal(&ErrCannotSet{"Table.TrySend"}))
+		gomega.Expect(ct.Close()).Should(gomega.Equal(&ErrCannotSet{"Table.Close"}))
+		ch <- 1
+		v, ok, err := ct.TryRecv()
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(ok).Should(gomega.BeTrue())
+		gomega.Expect(v.Frozen()).Should(gomega.BeTrue())
+	})
+	Specify("of scalars", func() {
+		t := Freeze(1)
+		err := t.EachDo(func(k, v *Table) error {
+			gomega.Expect(k).Should(gomega.BeNil())
+			gomega.Expect(v.Frozen()).Should(gomega.BeTrue())
+			gomega.Expect(v).ShouldNot(gomega.BeIdenticalTo(t))
+			return nil
+		})
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(t.EachDoContext(context.Background(), func(k, v *Table) error { return nil })).Should(gomega.BeNil())
+		for k, v := range t.All() {
+			gomega.Expect(k).Should(gomega.BeNil())
+			gomega.Expect(v.MustInt()).Should(gomega.Equal(1))
+		}
+		for k := range t.Keys() {
+			gomega.Expect(k).Should(gomega.BeNil())
+		}
+		for v := range t.Values() {
+			gomega.Expect(v.Frozen()).Should(gomega.BeTrue())
+		}
+	})
+	Specify("by concurrent readers of a child", func() {
+		t := Freeze(x)
+		for _, c := range []*Table{t.MustGet("a"), t.MustGetPath("a.c"), t.At("d").At(0)} {
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					gomega.Expect(c.Interface()).ShouldNot(gomega.BeNil())
+					gomega.Expect(c.Frozen()).Should(gomega.BeTrue())
+				}()
+			}
+			wg.Wait()
+		}
+	})
+	Specify("With", func() {
+		t := Freeze(x)
+		n, err := t.With("a.b", 10)
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(n.Frozen()).Should(gomega.BeTrue())
+		gomega.Expect(n.MustGetPath("a.b").MustInt()).Should(gomega.Equal(10))
+		gomega.Expect(t.MustGetPath("a.b").MustInt()).Should(gomega.Equal(1))
+		// the unchanged values are shared
+		gomega.Expect(reflect.ValueOf(n.MustGetPath("a.c").Interface()).Pointer()).
+			Should(gomega.Equal(reflect.ValueOf(x["a"].(map[string]interface{})["c"]).Pointer()))
+		gomega.Expect(n.MustGet("p").Interface()).Should(gomega.BeIdenticalTo(x["p"]))
+
+		n = t.MustWith("a.c.1", 20).MustWith("d.1.g", 3).MustWith("p.Y", 4).MustWith("h", "i")
+		gomega.Expect(n.Interface()).Should(gomega.Equal(map[string]interface{}{
+			"a": map[string]interface{}{"b": 1, "c": []int{1, 20}},
+			"d": []interface{}{"e", map[string]int{"f": 2, "g": 3}},
+			"p": &point{X: 1, Y: 4},
+			"h": "i",
+		}))
+		gomega.Expect(x["p"]).Should(gomega.Equal(&point{X: 1}))
+		gomega.Expect(x["a"].(map[string]interface{})["c"]).Should(gomega.Equal([]int{1, 2}))
+
+		gomega.Expect(t.MustWith("", 1).Interface()).Should(gomega.Equal(1))
+	})
+	Specify("With errors", func() {
+		t := Freeze(x)
+		ExpectErr(t.With("a.c.x", 1)).Should(gomega.Equal(&ErrOutOfRange{"Table.With"}))
+		ExpectErr(t.With("a.c.2", 1)).Should(gomega.Equal(&ErrOutOfRange{"Table.With"}))
+		ExpectErr(t.With("a.c.0", "s")).Should(gomega.Equal(&ErrTypeUnequal{"Table.With", reflect.Int, reflect.String}))
+		ExpectErr(t.With("x.y", 1)).Should(gomega.Equal(&ErrNotExist{"Table.With", "value at x.y"}))
+		ExpectErr(t.With("a.b.c", 1)).Should(gomega.Equal(&ErrUnsupportedKind{"Table.With", reflect.Int}))
+		ExpectErr(t.With("p.z", 1)).Should(gomega.Equal(&ErrCannotSet{"Table.With"}))
+		ExpectErr(Freeze(map[int]int{}).With("x", 5)).Should(gomega.Equal(&ErrTypeUnequal{"Table.With", reflect.Int, reflect.String}))
+	})
+	Specify("Without", func() {
+		t := Freeze(x)
+		n := t.MustWithout("a.b").MustWithout("d.0").MustWithout("x.y")
+		gomega.Expect(n.Frozen()).Should(gomega.BeTrue())
+		gomega.Expect(n.Interface()).Should(gomega.Equal(map[string]interface{}{
+			"a": map[string]interface{}{"c": []int{1, 2}},
+			"d": []interface{}{map[string]int{"f": 2}},
+			"p": &point{X: 1},
+		}))
+		gomega.Expect(x["a"]).Should(gomega.HaveKey("b"))
+		gomega.Expect(x["d"]).Should(gomega.HaveLen(2))
+
+		ExpectErr(t.Without("p.X")).Should(gomega.Equal(&ErrUnsupportedKind{"Table.Without", reflect.Struct}))
+		n, err := t.Without("a.b.c")
+		gomega.Expect(n).Should(gomega.BeNil())
+		gomega.Expect(err).Should(gomega.Equal(&ErrUnsupportedKind{"Table.GetPath", reflect.Int}))
+		ExpectErr(Freeze([1]int{1}).Without(0)).Should(gomega.Equal(&ErrUnsupportedKind{"Table.Without", reflect.Array}))
+	})
+	Specify("Merge", func() {
+		t := Freeze(x)
+		n, err := t.Merge(New(map[string]interface{}{
+			"a": map[string]interface{}{"b": 2, "g": 3},
+			"d": "d",
+		}))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(n.Frozen()).Should(gomega.BeTrue())
+		gomega.Expect(n.Interface()).Should(gomega.Equal(map[string]interface{}{
+			"a": map[string]interface{}{"b": 2, "c": []int{1, 2}, "g": 3},
+			"d": "d",
+			"p": &point{X: 1},
+		}))
+		gomega.Expect(x["a"]).Should(gomega.Equal(map[string]interface{}{"b": 1, "c": []int{1, 2}}))
+		gomega.Expect(n.MustGet("p").Interface()).Should(gomega.BeIdenticalTo(x["p"]))
+
+		typed := Freeze(map[string]map[string]int{"x": {"p": 1}})
+		gomega.Expect(typed.MustMerge(New(map[string]interface{}{"x": map[string]interface{}{"q": 2}})).Interface()).
+			Should(gomega.Equal(map[string]map[string]int{"x": {"p": 1, "q": 2}}))
+
+		ExpectErr(t.Merge(New(1))).Should(gomega.Equal(&ErrUnsupportedKind{"Table.Merge", reflect.Int}))
+		ExpectErr(Freeze(map[string]int{}).Merge(New(map[string]string{"a": "b"}))).
+			Should(gomega.Equal(&ErrTypeUnequal{"Table.Merge", reflect.Int, reflect.String}))
+	})
+})
@@ -0,0 +1,89 @@
+//go:build ignore
+
+// gen_must generates must.go, the Must* api for every getter of Table.
+//
+// Run it by go generate.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"text/template"
+)
+
+type must struct {
+	Name   string
+	Params string // parameters of the getter
+	Args   string // arguments passing the parameters
+	Result string // result type of the getter except error, empty for none
+}
+
+var musts = []must{
+	{Name: "Get", Params: "k interface{}", Args: "k", Result: "*Table"},
+	{Name: "GetPath", Params: "path interface{}", Args: "path", Result: "*Table"},
+	{Name: "Set", Params: "v interface{}", Args: "v"},
+	{Name: "Put", Params: "k, v interface{}", Args: "k, v"},
+	{Name: "Insert", Params: "idx int, v interface{}", Args: "idx, v"},
+	{Name: "Delete", Params: "idx int", Args: "idx"},
+	{Name: "With", Params: "path, value interface{}", Args: "path, value", Result: "*Table"},
+	{Name: "Without", Params: "path interface{}", Args: "path", Result: "*Table"},
+	{Name: "Merge", Params: "o *Table", Args: "o", Result: "*Table"},
+	{Name: "ConvTo", Params: "value interface{}", Args: "value"},
+	{Name: "Bytes", Result: "[]byte"},
+	{Name: "Bool", Result: "bool"},
+	{Name: "Int", Result: "int"},
+	{Name: "Int8", Result: "int8"},
+	{Name: "Int16", Result: "int16"},
+	{Name: "Int32", Result: "int32"},
+	{Name: "Int64", Result: "int64"},
+	{Name: "Uint", Result: "uint"},
+	{Name: "Uint8", Result: "uint8"},
+	{Name: "Uint16", Result: "uint16"},
+	{Name: "Uint32", Result: "uint32"},
+	{Name: "Uint64", Result: "uint64"},
+	{Name: "Float32", Result: "float32"},
+	{Name: "Float64", Result: "float64"},
+	{Name: "Complex64", Result: "complex64"},
+	{Name: "Complex128", Result: "complex128"},
+	{Name: "String", Result: "string"},
+	{Name: "Map", Result: "map[*Table]*Table"},
+	{Name: "Slice", Result: "[]*Table"},
+	{Name: "AList", Result: "[][2]*Table"},
+	{Name: "PList", Result: "[]*Table"},
+}
+
+var tmpl = template.Must(template.New("must").Parse(`// Code generated by gen_must.go; DO NOT EDIT.
+
+package table
+{{range .}}
+// Must{{.Name}} must api for {{.Name}}
+func (t *Table) Must{{.Name}}({{.Params}}) {{.Result}} {
+{{- if .Result}}
+	v, err := t.{{.Name}}({{.Args}})
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+{{- else}}
+	if err := t.{{.Name}}({{.Args}}); err != nil {
+		panic(t.mustErr(err))
+	}
+{{- end}}
+}
+{{end}}`))
+
+func main() {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, musts); err != nil {
+		log.Fatalln(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := os.WriteFile("must.go", src, 0644); err != nil {
+		log.Fatalln(err)
+	}
+}
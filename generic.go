@@ -0,0 +1,54 @@
+package table
+
+import (
+	"reflect"
+)
+
+// As converts t's underlying value to T, like ConvTo does.
+//
+// So As[[]string], As[map[string]Config] and As[time.Duration] all work
+// as ConvTo with a pointer of them.
+// It returns ErrNotExist if t is the nil *Table, or the error t carries.
+func As[T any](t *Table) (T, error) {
+	var x T
+	if err := t.check("table.As"); err != nil {
+		return x, err
+	}
+	err := t.convTo(reflect.ValueOf(&x).Elem())
+	return x, err
+}
+
+// MustAs must api for As
+func MustAs[T any](t *Table) T {
+	x, err := As[T](t)
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return x
+}
+
+// GetAs returns the value at path below t converted to T.
+// The path is as of GetPath.
+// It returns ErrNotExist if nothing is at path.
+func GetAs[T any](t *Table, path interface{}) (T, error) {
+	var x T
+	v, err := t.GetPath(path)
+	if err != nil {
+		return x, err
+	}
+	if v == nil {
+		return x, &ErrNotExist{"table.GetAs", toPath(path).String()}
+	}
+	return As[T](v)
+}
+
+// GetOr returns the value at path below t converted to T,
+// or def if nothing is at path or it can't be converted to T.
+// It's the same as Or.
+func GetOr[T any](t *Table, path interface{}, def T) T {
+	x, err := GetAs[T](t, path)
+	if err != nil {
+		return def
+	}
+	return x
+}
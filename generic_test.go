@@ -0,0 +1,55 @@
+package table
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Generics", func() {
+	type config struct {
+		Host    string
+		Port    int
+		Timeout time.Duration
+	}
+	x := map[string]interface{}{
+		"names": []interface{}{"a", "b"},
+		"configs": map[string]interface{}{
+			"db": map[string]interface{}{"Host": "h", "Port": 1, "Timeout": "1s"},
+		},
+		"timeout": "2s",
+		"ids":     map[int]string{1: "one"},
+	}
+	t := New(x)
+
+	Specify("with As()", func() {
+		gomega.Expect(As[[]string](t.MustGet("names"))).Should(gomega.Equal([]string{"a", "b"}))
+		gomega.Expect(As[map[string]config](t.MustGet("configs"))).Should(gomega.Equal(map[string]config{
+			"db": {"h", 1, time.Second},
+		}))
+		gomega.Expect(As[time.Duration](t.MustGet("timeout"))).Should(gomega.Equal(2 * time.Second))
+		gomega.Expect(As[interface{}](New(nil))).Should(gomega.BeNil())
+
+		ExpectErr(As[int](New("a"))).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+	})
+	Specify("with MustAs()", func() {
+		gomega.Expect(MustAs[string](New("a"))).Should(gomega.Equal("a"))
+		gomega.Expect(func() { MustAs[int](New("a")) }).Should(gomega.Panic())
+	})
+	Specify("with GetAs()", func() {
+		gomega.Expect(GetAs[string](t, "names.1")).Should(gomega.Equal("b"))
+		gomega.Expect(GetAs[int](t, "configs.db.Port")).Should(gomega.Equal(1))
+		gomega.Expect(GetAs[string](t, "ids.1")).Should(gomega.Equal("one"))
+		gomega.Expect(GetAs[string](t, Path{"ids", 1})).Should(gomega.Equal("one"))
+
+		ExpectErr(GetAs[int](t, "configs.x")).To(gomega.BeAssignableToTypeOf((*ErrNotExist)(nil)))
+		ExpectErr(GetAs[int](t, "timeout.x")).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+	})
+	Specify("with GetOr()", func() {
+		gomega.Expect(GetOr(t, "configs.db.Host", "x")).Should(gomega.Equal("h"))
+		gomega.Expect(GetOr(t, "configs.db.User", "x")).Should(gomega.Equal("x"))
+		gomega.Expect(GetOr(t, "names.5", "x")).Should(gomega.Equal("x"))
+		gomega.Expect(GetOr(t, "configs.db.Host", 0)).Should(gomega.Equal(0))
+	})
+})
@@ -0,0 +1,239 @@
+package table
+
+import (
+	"math"
+	"reflect"
+)
+
+// records returns the elements of t's underlying array or slice,
+// every one is got at its index like At does.
+func (t *Table) records(method string) (reflect.Value, []*Table, error) {
+	if err := t.check(method); err != nil {
+		return reflect.Value{}, nil, err
+	}
+
+	sv := indirect(t.getv())
+	if sv.Kind() != reflect.Array && sv.Kind() != reflect.Slice {
+		return sv, nil, &ErrUnsupportedKind{method, sv.Kind()}
+	}
+
+	rs := make([]*Table, sv.Len())
+	for i := range rs {
+		rs[i] = t.record(sv, i)
+	}
+	return sv, rs, nil
+}
+
+// record returns the i'th element of sv, t's underlying array or slice.
+func (t *Table) record(sv reflect.Value, i int) *Table {
+	r := t.child(sv.Index(i))
+	r.path = t.path.append(i)
+	return r
+}
+
+// fieldValues returns the non-nil values at path of the records of t,
+// as of GetPath.
+func (t *Table) fieldValues(method, path string) ([]*Table, error) {
+	_, rs, err := t.records(method)
+	if err != nil {
+		return nil, err
+	}
+
+	var vs []*Table
+	for _, r := range rs {
+		v, err := r.GetPath(path)
+		if err != nil {
+			return nil, r.mustErr(err)
+		}
+		if v != nil && indirect(v.getv()).IsValid() {
+			vs = append(vs, v)
+		}
+	}
+	return vs, nil
+}
+
+// GroupBy groups the records of t's underlying array or slice, e.g. maps,
+// structs or pointers to them, by their values at path, as of GetPath.
+//
+// It returns a map of the values to sub-slices of the records in their order,
+// which are of t's type, or of a slice of the element type for an array.
+// Numbers of any kinds are grouped by value like Join does, keyed as int64
+// if they're integral, e.g. 1 and 1.0 are both of the key int64(1), or else
+// as float64, and NaNs are grouped together under a NaN key. Values of named
+// bool, string or complex types are keyed as of their base types, e.g. a
+// type Color string is of a string key. A missing or nil value groups its
+// records under the nil key.
+// It returns error if a value is not comparable, such as a map or slice or
+// a struct holding one in an interface, or is of an unexported struct field.
+func (t *Table) GroupBy(path string) (map[interface{}]*Table, error) {
+	sv, rs, err := t.records("Table.GroupBy")
+	if err != nil {
+		return nil, err
+	}
+
+	st := sv.Type()
+	if st.Kind() == reflect.Array {
+		st = reflect.SliceOf(st.Elem())
+	}
+
+	groups := map[interface{}]reflect.Value{}
+	var keys []interface{}
+	for _, r := range rs {
+		v, err := r.GetPath(path)
+		if err != nil {
+			return nil, r.mustErr(err)
+		}
+
+		var k interface{}
+		if v != nil {
+			kv := indirect(v.getv())
+			if kv.IsValid() && !kv.CanInterface() {
+				return nil, v.mustErr(&ErrUnsupportedKind{"Table.GroupBy", kv.Kind()})
+			}
+			if k, err = hashKey("Table.GroupBy", kv); err != nil {
+				return nil, v.mustErr(err)
+			}
+		}
+
+		g, ok := groups[k]
+		if !ok {
+			g = reflect.MakeSlice(st, 0, 1)
+			keys = append(keys, k)
+		}
+		groups[k] = reflect.Append(g, r.getv())
+	}
+
+	m := make(map[interface{}]*Table, len(groups))
+	for _, k := range keys {
+		g := t.child(groups[k])
+		if _, ok := k.(nanKey); ok {
+			k = math.NaN()
+		}
+		m[k] = g
+	}
+	return m, nil
+}
+
+// Sum returns the sum of the values at path of the records of t's underlying
+// array or slice, as float64 of Float64. Missing and nil values are passed.
+func (t *Table) Sum(path string) (float64, error) {
+	vs, err := t.fieldValues("Table.Sum", path)
+	if err != nil {
+		return 0, err
+	}
+
+	sum := 0.0
+	for _, v := range vs {
+		f, err := v.Float64()
+		if err != nil {
+			return 0, v.mustErr(err)
+		}
+		sum += f
+	}
+	return sum, nil
+}
+
+// SumInt is like Sum, but sums values as int64 of Int64.
+func (t *Table) SumInt(path string) (int64, error) {
+	vs, err := t.fieldValues("Table.SumInt", path)
+	if err != nil {
+		return 0, err
+	}
+
+	var sum int64
+	for _, v := range vs {
+		i, err := v.Int64()
+		if err != nil {
+			return 0, v.mustErr(err)
+		}
+		sum += i
+	}
+	return sum, nil
+}
+
+// Avg returns the average of the values at path of the records of t's
+// underlying array or slice, as of Sum.
+// It returns error if there is no value.
+func (t *Table) Avg(path string) (float64, error) {
+	vs, err := t.fieldValues("Table.Avg", path)
+	if err != nil {
+		return 0, err
+	}
+	if len(vs) == 0 {
+		return 0, &ErrNotExist{"Table.Avg", "value at " + path}
+	}
+
+	sum := 0.0
+	for _, v := range vs {
+		f, err := v.Float64()
+		if err != nil {
+			return 0, v.mustErr(err)
+		}
+		sum += f
+	}
+	return sum / float64(len(vs)), nil
+}
+
+// Min returns the least of the values at path of the records of t's
+// underlying array or slice, in the natural order of SortBy.
+// Missing and nil values are passed, and it returns the nil if there is no value.
+func (t *Table) Min(path string) (*Table, error) {
+	return t.extreme("Table.Min", path, -1)
+}
+
+// Max returns the greatest of the values at path of the records of t's
+// underlying array or slice, as of Min.
+func (t *Table) Max(path string) (*Table, error) {
+	return t.extreme("Table.Max", path, 1)
+}
+
+func (t *Table) extreme(method, path string, sign int) (*Table, error) {
+	vs, err := t.fieldValues(method, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *Table
+	for _, v := range vs {
+		if r == nil || compareValues(v.getv(), r.getv()) == sign {
+			r = v
+		}
+	}
+	return r, nil
+}
+
+// CountOf returns the number of the records of t's underlying array or slice
+// which have a non-nil value at path, or which are not nil for the empty path.
+func (t *Table) CountOf(path string) (int, error) {
+	vs, err := t.fieldValues("Table.CountOf", path)
+	return len(vs), err
+}
+
+// Distinct returns the distinct values at path of the records of t's
+// underlying array or slice in their first order.
+// Numbers of any kinds are compared by value, others are compared deeply,
+// and missing and nil values are passed.
+func (t *Table) Distinct(path string) ([]interface{}, error) {
+	vs, err := t.fieldValues("Table.Distinct", path)
+	if err != nil {
+		return nil, err
+	}
+
+	var seen []reflect.Value
+	ds := []interface{}{}
+next:
+	for _, v := range vs {
+		ev := indirect(v.getv())
+		if !ev.CanInterface() {
+			continue
+		}
+		for _, s := range seen {
+			if valueEqual(s, ev) {
+				continue next
+			}
+		}
+		seen = append(seen, ev)
+		ds = append(ds, ev.Interface())
+	}
+	return ds, nil
+}
@@ -0,0 +1,123 @@
+package table
+
+import (
+	"math"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("GroupBy", func() {
+	Specify("slice of maps", func() {
+		x := []map[string]interface{}{
+			{"region": "east", "amount": 1},
+			{"region": "west", "amount": 2},
+			{"region": "east", "amount": 3},
+			{"amount": 4},
+		}
+		g, err := New(x).GroupBy("region")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(g).Should(gomega.HaveLen(3))
+		gomega.Expect(g["east"].Interface()).Should(gomega.Equal([]map[string]interface{}{x[0], x[2]}))
+		gomega.Expect(g["west"].Interface()).Should(gomega.Equal([]map[string]interface{}{x[1]}))
+		gomega.Expect(g[nil].Interface()).Should(gomega.Equal([]map[string]interface{}{x[3]}))
+	})
+	Specify("array of structs", func() {
+		type rec struct{ R, N int }
+		x := [3]rec{{1, 1}, {2, 2}, {1, 3}}
+		g, err := New(x).GroupBy("R")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(g[int64(1)].Interface()).Should(gomega.Equal([]rec{{1, 1}, {1, 3}}))
+	})
+	Specify("numbers by value", func() {
+		x := []interface{}{map[string]interface{}{"n": 1}, map[string]interface{}{"n": 1.0}, map[string]interface{}{"n": 1.5}}
+		g, err := New(x).GroupBy("n")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(g).Should(gomega.HaveLen(2))
+		gomega.Expect(g[int64(1)].Len()).Should(gomega.Equal(2))
+		gomega.Expect(g[1.5].Len()).Should(gomega.Equal(1))
+	})
+	Specify("named strings and NaNs", func() {
+		type color string
+		type rec struct {
+			C color
+			F float64
+		}
+		x := []rec{{"red", math.NaN()}, {"blue", 1}, {"red", math.NaN()}}
+		g, err := New(x).GroupBy("C")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(g).Should(gomega.HaveLen(2))
+		gomega.Expect(g["red"].Len()).Should(gomega.Equal(2))
+
+		g, err = New(x).GroupBy("F")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(g).Should(gomega.HaveLen(2))
+		for k, v := range g {
+			if f, ok := k.(float64); ok {
+				gomega.Expect(math.IsNaN(f)).Should(gomega.BeTrue())
+				gomega.Expect(v.Len()).Should(gomega.Equal(2))
+			}
+		}
+	})
+	Specify("uncomparable value in an interface", func() {
+		type key struct{ V interface{} }
+		x := []interface{}{map[string]interface{}{"a": key{[]int{1}}}}
+		ExpectErr(New(x).GroupBy("a")).Should(gomega.Equal(&ErrPath{Path{0, "a"}, &ErrUnsupportedKind{"Table.GroupBy", reflect.Struct}}))
+	})
+	Specify("unexported field", func() {
+		x := []struct{ r int }{{1}}
+		ExpectErr(New(x).GroupBy("r")).Should(gomega.Equal(&ErrPath{Path{0, "r"}, &ErrUnsupportedKind{"Table.GroupBy", reflect.Int}}))
+	})
+	Specify("uncomparable value", func() {
+		x := []interface{}{map[string]interface{}{"a": []int{1}}}
+		ExpectErr(New(x).GroupBy("a")).Should(gomega.Equal(&ErrPath{Path{0, "a"}, &ErrUnsupportedKind{"Table.GroupBy", reflect.Slice}}))
+	})
+	Specify("not slice", func() {
+		ExpectErr(New(1).GroupBy("a")).Should(gomega.Equal(&ErrUnsupportedKind{"Table.GroupBy", reflect.Int}))
+	})
+})
+
+var _ = Describe("Aggregates", func() {
+	type rec struct {
+		Name   string
+		Amount interface{}
+	}
+	x := []*rec{
+		{"a", 1},
+		{"b", 2.5},
+		{"c", uint8(3)},
+		{"d", nil},
+		nil,
+	}
+
+	Specify("Sum", func() {
+		gomega.Expect(New(x).Sum("Amount")).Should(gomega.Equal(6.5))
+	})
+	Specify("SumInt", func() {
+		gomega.Expect(New([]map[string]int{{"a": 1}, {"a": 2}}).SumInt("a")).Should(gomega.Equal(int64(3)))
+	})
+	Specify("Sum not number", func() {
+		ExpectErr(New(x).Sum("Name")).Should(gomega.Equal(&ErrPath{Path{0, "Name"}, &ErrUnsupportedKind{"Table.Float64", reflect.String}}))
+	})
+	Specify("Avg", func() {
+		gomega.Expect(New(x).Avg("Amount")).Should(gomega.BeNumerically("~", 6.5/3))
+	})
+	Specify("Avg of none", func() {
+		ExpectErr(New([]int{}).Avg("a")).Should(gomega.Equal(&ErrNotExist{"Table.Avg", "value at a"}))
+	})
+	Specify("Min and Max", func() {
+		gomega.Expect(must(New(x).Min("Amount")).Interface()).Should(gomega.Equal(1))
+		gomega.Expect(must(New(x).Max("Amount")).Interface()).Should(gomega.Equal(uint8(3)))
+		gomega.Expect(must(New(x).Max("Name")).Interface()).Should(gomega.Equal("d"))
+		gomega.Expect(New([]int{}).Min("")).Should(gomega.BeNil())
+	})
+	Specify("CountOf", func() {
+		gomega.Expect(New(x).CountOf("Amount")).Should(gomega.Equal(3))
+		gomega.Expect(New(x).CountOf("")).Should(gomega.Equal(4))
+	})
+	Specify("Distinct", func() {
+		y := []map[string]interface{}{{"a": 1}, {"a": 1.0}, {"a": "1"}, {"a": uint(2)}, {}}
+		gomega.Expect(New(y).Distinct("a")).Should(gomega.Equal([]interface{}{1, "1", uint(2)}))
+	})
+})
@@ -0,0 +1,282 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Index is a hash index of the records of a Table's underlying array or slice
+// by their values at a path, see Table.Index.
+//
+// The index is rebuilt on the next lookup after the records are changed by
+// Set, Put, Insert, Delete or SortBy of the same Table. Changes made otherwise, such
+// as through the Tables of the records, are not seen until Rebuild.
+// As lookups may rebuild it, an Index isn't safe for concurrent use.
+type Index struct {
+	t      *Table
+	path   string
+	unique bool
+
+	stale bool
+	keys  map[interface{}][]int
+	err   error
+}
+
+// IndexOption configures Index.
+type IndexOption func(*Index)
+
+// IndexUnique makes the index unique, no two records can have equal values.
+//
+// Building the index fails with ErrConflict if they do, and Put and Insert of
+// the Table refuse records which would.
+func IndexUnique() IndexOption {
+	return func(ix *Index) {
+		ix.unique = true
+	}
+}
+
+// Index builds an index of the records of t's underlying array or slice, e.g.
+// maps, structs or pointers to them, by their values at path, as of GetPath.
+//
+// Numbers of any kinds are indexed by value, records of missing or nil values
+// are not indexed. The error of building it is carried by the Index, see Err.
+//
+// The index is kept by t until Drop, and the same one is returned for the
+// same path and options. The index of a frozen Table isn't kept by it,
+// as its records can't change.
+func (t *Table) Index(path string, opts ...IndexOption) *Index {
+	ix := &Index{t: t, path: path, stale: true}
+	for _, opt := range opts {
+		opt(ix)
+	}
+	if t != nil && t.err == nil && !t.frozen {
+		for _, kept := range t.indexes {
+			if kept.path == ix.path && kept.unique == ix.unique {
+				kept.refresh()
+				return kept
+			}
+		}
+		t.indexes = append(t.indexes, ix)
+	}
+	ix.refresh()
+	return ix
+}
+
+// Drop drops ix from its Table, which no longer keeps it up to date by Put,
+// Insert or Delete, and no longer refuses records violating it.
+func (ix *Index) Drop() {
+	t := ix.t
+	if t == nil {
+		return
+	}
+	for i, kept := range t.indexes {
+		if kept == ix {
+			t.indexes = append(t.indexes[:i:i], t.indexes[i+1:]...)
+			return
+		}
+	}
+}
+
+// Err returns the error of building ix.
+func (ix *Index) Err() error {
+	ix.refresh()
+	return ix.err
+}
+
+// Rebuild rebuilds ix from the current records of its Table.
+func (ix *Index) Rebuild() error {
+	ix.stale = true
+	return ix.Err()
+}
+
+// Lookup returns the first record whose value is equal to v,
+// or the nil if there is none.
+func (ix *Index) Lookup(v interface{}) (*Table, error) {
+	rs, err := ix.lookup("Index.Lookup", v)
+	if err != nil || len(rs) == 0 {
+		return nil, err
+	}
+	return rs[0], nil
+}
+
+// LookupAll returns the records whose values are equal to v in their order.
+func (ix *Index) LookupAll(v interface{}) ([]*Table, error) {
+	return ix.lookup("Index.LookupAll", v)
+}
+
+func (ix *Index) lookup(method string, v interface{}) ([]*Table, error) {
+	if err := ix.Err(); err != nil {
+		return nil, err
+	}
+	k, err := hashKey(method, indirect(reflect.ValueOf(v)))
+	if err != nil || k == nil {
+		return nil, err
+	}
+
+	sv := indirect(ix.t.getv())
+	var rs []*Table
+	for _, i := range ix.keys[k] {
+		rs = append(rs, ix.t.record(sv, i))
+	}
+	return rs, nil
+}
+
+// refresh rebuilds ix if it's stale. A unique index of records violating it
+// is built still, with the ErrConflict, so that it refuses more of them.
+func (ix *Index) refresh() {
+	if !ix.stale {
+		return
+	}
+	ix.stale = false
+	ix.keys, ix.err = nil, nil
+
+	_, recs, err := ix.t.records("Table.Index")
+	if err != nil {
+		ix.err = err
+		return
+	}
+	keys := map[interface{}][]int{}
+	for i, r := range recs {
+		k, err := ix.key(r)
+		if err != nil {
+			ix.err = err
+			return
+		}
+		if k == nil {
+			continue
+		}
+		if ix.unique && len(keys[k]) > 0 && ix.err == nil {
+			ix.err = &ErrConflict{"Table.Index", fmt.Sprintf("%v of %v", ix.path, k)}
+		}
+		keys[k] = append(keys[k], i)
+	}
+	ix.keys = keys
+}
+
+// key returns the key of the record r, the nil if r has no value.
+func (ix *Index) key(r *Table) (interface{}, error) {
+	v, err := r.GetPath(ix.path)
+	if err != nil {
+		return nil, r.mustErr(err)
+	}
+	if v == nil {
+		return nil, nil
+	}
+	k, err := hashKey("Table.Index", indirect(v.getv()))
+	if err != nil {
+		return nil, v.mustErr(err)
+	}
+	return k, nil
+}
+
+// checkIndexes returns ErrConflict if the record x at the index idx of t,
+// or inserted if idx is negative, violates any unique index of t.
+// It returns the error of building a unique index if it can't be built.
+func (t *Table) checkIndexes(method string, idx int, x interface{}) error {
+	for _, ix := range t.indexes {
+		if !ix.unique {
+			continue
+		}
+		if err := ix.Err(); err != nil && ix.keys == nil {
+			return err
+		}
+		k, err := ix.key(New(x))
+		if err != nil || k == nil {
+			continue
+		}
+		for _, i := range ix.keys[k] {
+			if i != idx {
+				return &ErrConflict{method, fmt.Sprintf("%v of %v", ix.path, k)}
+			}
+		}
+	}
+	return nil
+}
+
+// touchIndexes marks the indexes of t stale.
+func (t *Table) touchIndexes() {
+	for _, ix := range t.indexes {
+		ix.stale = true
+	}
+}
+
+// Insert inserts v into t's underlying slice at the index idx,
+// moving the elements from idx up.
+//
+// It returns ErrOutOfRange if idx is not in [0, len], ErrTypeUnequal if v is
+// not assignable to the elements, or ErrConflict if v violates a unique Index of t.
+func (t *Table) Insert(idx int, v interface{}) error {
+	if err := t.checkSet("Table.Insert"); err != nil {
+		return err
+	}
+	sv, set, err := t.sliceTarget("Table.Insert")
+	if err != nil {
+		return err
+	}
+
+	l := sv.Len()
+	if idx < 0 || idx > l {
+		return &ErrOutOfRange{"Table.Insert"}
+	}
+	x, ok := assignable(reflect.ValueOf(v), sv.Type().Elem())
+	if !ok {
+		return &ErrTypeUnequal{"Table.Insert", sv.Type().Elem().Kind(), x.Kind()}
+	}
+	if err := t.checkIndexes("Table.Insert", -1, v); err != nil {
+		return err
+	}
+
+	ns := reflect.MakeSlice(sv.Type(), l+1, l+1)
+	reflect.Copy(ns, sv.Slice(0, idx))
+	ns.Index(idx).Set(x)
+	reflect.Copy(ns.Slice(idx+1, l+1), sv.Slice(idx, l))
+	set(ns)
+	t.touchIndexes()
+	return nil
+}
+
+// Delete deletes the element at the index idx of t's underlying slice,
+// moving the elements after it down.
+// It returns ErrOutOfRange if idx is not in [0, len).
+func (t *Table) Delete(idx int) error {
+	if err := t.checkSet("Table.Delete"); err != nil {
+		return err
+	}
+	sv, set, err := t.sliceTarget("Table.Delete")
+	if err != nil {
+		return err
+	}
+
+	l := sv.Len()
+	if idx < 0 || idx >= l {
+		return &ErrOutOfRange{"Table.Delete"}
+	}
+
+	ns := reflect.MakeSlice(sv.Type(), l-1, l-1)
+	reflect.Copy(ns, sv.Slice(0, idx))
+	reflect.Copy(ns.Slice(idx, l-1), sv.Slice(idx+1, l))
+	set(ns)
+	t.touchIndexes()
+	return nil
+}
+
+// sliceTarget returns t's underlying slice and the function replacing it,
+// in t itself or through the pointer t is.
+func (t *Table) sliceTarget(method string) (reflect.Value, func(reflect.Value), error) {
+	tv := t.getv()
+	if tv.Kind() == reflect.Slice {
+		return tv, func(ns reflect.Value) {
+			t.v = ns
+			t.i = ns.Interface()
+		}, nil
+	}
+
+	sv := indirect(tv)
+	if sv.Kind() != reflect.Slice {
+		return sv, nil, &ErrUnsupportedKind{method, sv.Kind()}
+	}
+	if !sv.CanSet() {
+		return sv, nil, &ErrCannotSet{method}
+	}
+	return sv, sv.Set, nil
+}
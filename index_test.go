@@ -0,0 +1,145 @@
+package table
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Index", func() {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	Specify("Lookup and LookupAll", func() {
+		x := []map[string]interface{}{
+			{"id": 1, "g": "a"},
+			{"id": 2, "g": "b"},
+			{"id": 3, "g": "a"},
+			{"id": 4},
+		}
+		t := New(x)
+		gomega.Expect(must(t.Index("id").Lookup(2.0)).Interface()).Should(gomega.Equal(x[1]))
+		gomega.Expect(t.Index("id").Lookup(5)).Should(gomega.BeNil())
+		gomega.Expect(t.Index("g").Lookup(nil)).Should(gomega.BeNil())
+
+		rs, err := t.Index("g").LookupAll("a")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(rs).Should(gomega.HaveLen(2))
+		gomega.Expect(rs[0].Interface()).Should(gomega.Equal(x[0]))
+		gomega.Expect(rs[1].Interface()).Should(gomega.Equal(x[2]))
+		gomega.Expect(rs[1].Path()).Should(gomega.Equal(Path{2}))
+	})
+	Specify("unique", func() {
+		t := New([]user{{1, "a"}, {1, "b"}})
+		gomega.Expect(t.Index("ID", IndexUnique()).Err()).Should(gomega.Equal(&ErrConflict{"Table.Index", "ID of 1"}))
+		gomega.Expect(t.Index("ID").Err()).Should(gomega.BeNil())
+	})
+	Specify("updated by Put, Insert and Delete", func() {
+		t := New([]user{{1, "a"}, {2, "b"}})
+		ix := t.Index("ID", IndexUnique())
+
+		gomega.Expect(t.Put(1, user{3, "c"})).Should(gomega.BeNil())
+		gomega.Expect(ix.Lookup(2)).Should(gomega.BeNil())
+		gomega.Expect(must(ix.Lookup(3)).Interface()).Should(gomega.Equal(user{3, "c"}))
+
+		gomega.Expect(t.Insert(0, user{4, "d"})).Should(gomega.BeNil())
+		gomega.Expect(must(ix.Lookup(1)).Path()).Should(gomega.Equal(Path{1}))
+
+		gomega.Expect(t.Delete(0)).Should(gomega.BeNil())
+		gomega.Expect(ix.Lookup(4)).Should(gomega.BeNil())
+		gomega.Expect(must(ix.Lookup(1)).Path()).Should(gomega.Equal(Path{0}))
+		gomega.Expect(t.Interface()).Should(gomega.Equal([]user{{1, "a"}, {3, "c"}}))
+	})
+	Specify("updated by SortBy", func() {
+		t := New([]user{{2, "b"}, {1, "a"}})
+		ix := t.Index("ID", IndexUnique())
+		gomega.Expect(must(ix.Lookup(1)).Path()).Should(gomega.Equal(Path{1}))
+
+		gomega.Expect(t.SortBy("ID")).Should(gomega.BeNil())
+		gomega.Expect(must(ix.Lookup(1)).Interface()).Should(gomega.Equal(user{1, "a"}))
+		gomega.Expect(must(ix.Lookup(1)).Path()).Should(gomega.Equal(Path{0}))
+	})
+	Specify("of named strings and NaNs", func() {
+		type name string
+		x := []map[string]interface{}{{"n": name("a"), "f": math.NaN()}, {"n": "b", "f": 1.0}}
+		t := New(x)
+		gomega.Expect(must(t.Index("n").Lookup("a")).Interface()).Should(gomega.Equal(x[0]))
+		gomega.Expect(must(t.Index("n").Lookup(name("b"))).Interface()).Should(gomega.Equal(x[1]))
+		gomega.Expect(must(t.Index("f").Lookup(math.NaN())).Path()).Should(gomega.Equal(Path{0}))
+	})
+	Specify("unique refuses", func() {
+		t := New([]user{{1, "a"}, {2, "b"}})
+		t.Index("ID", IndexUnique())
+		gomega.Expect(t.Put(0, user{1, "x"})).Should(gomega.BeNil())
+		gomega.Expect(t.Put(0, user{2, "x"})).Should(gomega.Equal(&ErrConflict{"Table.Put", "ID of 2"}))
+		gomega.Expect(t.Insert(2, user{1, "y"})).Should(gomega.Equal(&ErrConflict{"Table.Insert", "ID of 1"}))
+		gomega.Expect(t.Interface()).Should(gomega.Equal([]user{{1, "x"}, {2, "b"}}))
+	})
+	Specify("Rebuild", func() {
+		x := []user{{1, "a"}}
+		ix := New(x).Index("ID")
+		x[0].ID = 2
+		gomega.Expect(ix.Rebuild()).Should(gomega.BeNil())
+		gomega.Expect(must(ix.Lookup(2)).Interface()).Should(gomega.Equal(user{2, "a"}))
+	})
+	Specify("kept once until Drop", func() {
+		t := New([]user{{1, "a"}, {2, "b"}})
+		ix := t.Index("ID", IndexUnique())
+		gomega.Expect(t.Index("ID", IndexUnique())).Should(gomega.BeIdenticalTo(ix))
+		gomega.Expect(t.Index("ID")).ShouldNot(gomega.BeIdenticalTo(ix))
+		gomega.Expect(t.indexes).Should(gomega.HaveLen(2))
+
+		ix.Drop()
+		gomega.Expect(t.indexes).Should(gomega.HaveLen(1))
+		gomega.Expect(t.Put(0, user{2, "x"})).Should(gomega.BeNil())
+		gomega.Expect(t.Index("ID", IndexUnique())).ShouldNot(gomega.BeIdenticalTo(ix))
+	})
+	Specify("unique of conflicts refuses more", func() {
+		t := New([]user{{1, "a"}, {1, "b"}, {2, "c"}})
+		ix := t.Index("ID", IndexUnique())
+		gomega.Expect(ix.Err()).Should(gomega.Equal(&ErrConflict{"Table.Index", "ID of 1"}))
+		gomega.Expect(t.Put(2, user{1, "x"})).Should(gomega.Equal(&ErrConflict{"Table.Put", "ID of 1"}))
+		gomega.Expect(t.Put(1, user{3, "x"})).Should(gomega.BeNil())
+		gomega.Expect(ix.Err()).Should(gomega.BeNil())
+
+		u := New([]interface{}{map[string]interface{}{"ID": []int{1}}})
+		u.Index("ID", IndexUnique())
+		gomega.Expect(u.Put(0, 1)).Should(gomega.Equal(&ErrPath{Path{0, "ID"}, &ErrUnsupportedKind{"Table.Index", reflect.Slice}}))
+	})
+	Specify("Lookup builds only the records found", func() {
+		x := make([]user, 1000)
+		for i := range x {
+			x[i] = user{i, "a"}
+		}
+		ix := New(x).Index("ID")
+		allocs := testing.AllocsPerRun(10, func() {
+			_, _ = ix.Lookup(500)
+		})
+		gomega.Expect(allocs).Should(gomega.BeNumerically("<", 20))
+	})
+	Specify("not slice", func() {
+		gomega.Expect(New(1).Index("a").Err()).Should(gomega.HaveOccurred())
+	})
+})
+
+var _ = Describe("Insert and Delete", func() {
+	Specify("through pointer", func() {
+		x := []int{1, 3}
+		gomega.Expect(New(&x).Insert(1, 2)).Should(gomega.BeNil())
+		gomega.Expect(x).Should(gomega.Equal([]int{1, 2, 3}))
+		gomega.Expect(New(&x).Delete(2)).Should(gomega.BeNil())
+		gomega.Expect(x).Should(gomega.Equal([]int{1, 2}))
+	})
+	Specify("errors", func() {
+		t := New([]int{1})
+		gomega.Expect(t.Insert(2, 1)).Should(gomega.Equal(&ErrOutOfRange{"Table.Insert"}))
+		gomega.Expect(t.Delete(1)).Should(gomega.Equal(&ErrOutOfRange{"Table.Delete"}))
+		gomega.Expect(t.Insert(0, "a")).Should(gomega.Equal(&ErrTypeUnequal{"Table.Insert", reflect.Int, reflect.String}))
+		gomega.Expect(New(map[int]int{}).Delete(0)).Should(gomega.Equal(&ErrUnsupportedKind{"Table.Delete", reflect.Map}))
+	})
+})
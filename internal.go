@@ -4,8 +4,13 @@ import (
 	"reflect"
 )
 
+// getv and geti cache t's value, but not of a frozen Table,
+// which is read by concurrent readers.
 func (t *Table) getv() reflect.Value {
-	if t.v.Kind() == reflect.Invalid {
+	if t.v.Kind() == reflect.Invalid && t.i != nil {
+		if t.frozen {
+			return reflect.ValueOf(t.i)
+		}
 		t.v = reflect.ValueOf(t.i)
 	}
 
@@ -13,12 +18,28 @@ func (t *Table) getv() reflect.Value {
 }
 
 func (t *Table) geti() interface{} {
-	if t.i == nil {
+	if t.i == nil && t.v.IsValid() {
+		if t.frozen {
+			return t.v.Interface()
+		}
 		t.i = t.v.Interface()
 	}
 	return t.i
 }
 
+// valueTable returns a Table of v, the invalid v is the nil.
+func valueTable(v reflect.Value) *Table {
+	if !v.IsValid() {
+		return New(nil)
+	}
+	return &Table{v: v}
+}
+
+// child returns a Table of v got from t, it's frozen if t is.
+func (t *Table) child(v reflect.Value) *Table {
+	return &Table{v: v, frozen: t.frozen}
+}
+
 //// get op
 
 func (t *Table) mapGet(k interface{}) *Table {
@@ -26,7 +47,7 @@ func (t *Table) mapGet(k interface{}) *Table {
 	if v.Kind() == reflect.Invalid {
 		return nil
 	}
-	return &Table{v: v}
+	return t.child(v)
 }
 
 func (t *Table) sliceGet(idx int) *Table {
@@ -36,7 +57,7 @@ func (t *Table) sliceGet(idx int) *Table {
 	}
 
 	v := t.getv().Index(idx)
-	return &Table{v: v}
+	return t.child(v)
 }
 
 func (t *Table) structGet(field string) *Table {
@@ -44,7 +65,7 @@ func (t *Table) structGet(field string) *Table {
 	if v.Kind() == reflect.Invalid {
 		return nil
 	}
-	return &Table{v: v}
+	return t.child(v)
 }
 
 //// put op
@@ -135,7 +156,7 @@ func (t *Table) mapMap() map[*Table]*Table {
 	for iter.Next() {
 		k := iter.Key()
 		v := iter.Value()
-		m[&Table{v: k}] = &Table{v: v}
+		m[t.child(k)] = t.child(v)
 	}
 	return m
 }
@@ -146,7 +167,7 @@ func (t *Table) sliceMap() map[*Table]*Table {
 	v := t.getv()
 	for i := 0; i < l; i++ {
 		ev := v.Index(i)
-		m[&Table{i: i}] = &Table{v: ev}
+		m[&Table{i: i}] = t.child(ev)
 	}
 	return m
 }
@@ -159,7 +180,7 @@ func (t *Table) structMap() map[*Table]*Table {
 	for i := 0; i < num; i++ {
 		fn := rt.Field(i).Name
 		fv := rv.Field(i)
-		m[&Table{i: fn}] = &Table{v: fv}
+		m[&Table{i: fn}] = t.child(fv)
 	}
 	return m
 }
@@ -172,7 +193,7 @@ func (t *Table) sliceSlice() []*Table {
 	v := t.getv()
 	for i := 0; i < l; i++ {
 		ev := v.Index(i)
-		s[i] = &Table{v: ev}
+		s[i] = t.child(ev)
 	}
 	return s
 }
@@ -183,7 +204,7 @@ func (t *Table) structSlice() []*Table {
 	rv := t.getv()
 	for i := 0; i < num; i++ {
 		fv := rv.Field(i)
-		s[i] = &Table{v: fv}
+		s[i] = t.child(fv)
 	}
 	return s
 }
@@ -197,7 +218,7 @@ func (t *Table) mapAList() [][2]*Table {
 	for iter.Next() {
 		k := iter.Key()
 		v := iter.Value()
-		alist = append(alist, [2]*Table{&Table{v: k}, &Table{v: v}})
+		alist = append(alist, [2]*Table{t.child(k), t.child(v)})
 	}
 	return alist
 }
@@ -208,7 +229,7 @@ func (t *Table) sliceAList() [][2]*Table {
 	v := t.getv()
 	for i := 0; i < l; i++ {
 		ev := v.Index(i)
-		alist = append(alist, [2]*Table{&Table{i: i}, &Table{v: ev}})
+		alist = append(alist, [2]*Table{&Table{i: i}, t.child(ev)})
 	}
 	return alist
 }
@@ -221,7 +242,7 @@ func (t *Table) structAList() [][2]*Table {
 	for i := 0; i < num; i++ {
 		fn := rt.Field(i).Name
 		fv := rv.Field(i)
-		alist = append(alist, [2]*Table{&Table{i: fn}, &Table{v: fv}})
+		alist = append(alist, [2]*Table{&Table{i: fn}, t.child(fv)})
 	}
 	return alist
 }
@@ -235,7 +256,7 @@ func (t *Table) mapPList() []*Table {
 	for iter.Next() {
 		k := iter.Key()
 		v := iter.Value()
-		plist = append(plist, &Table{v: k}, &Table{v: v})
+		plist = append(plist, t.child(k), t.child(v))
 	}
 	return plist
 }
@@ -246,7 +267,7 @@ func (t *Table) slicePList() []*Table {
 	v := t.getv()
 	for i := 0; i < l; i++ {
 		ev := v.Index(i)
-		plist = append(plist, &Table{i: i}, &Table{v: ev})
+		plist = append(plist, &Table{i: i}, t.child(ev))
 	}
 	return plist
 }
@@ -259,7 +280,7 @@ func (t *Table) structPList() []*Table {
 	for i := 0; i < num; i++ {
 		fn := rt.Field(i).Name
 		fv := rv.Field(i)
-		plist = append(plist, &Table{i: fn}, &Table{v: fv})
+		plist = append(plist, &Table{i: fn}, t.child(fv))
 	}
 	return plist
 }
@@ -0,0 +1,46 @@
+package table
+
+import (
+	"iter"
+)
+
+// All returns an iterator over keys and values of t, in the order of EachDo.
+//
+// It yields lazily, so breaking out of the loop stops iterating,
+// a channel isn't received any further. It yields nothing if t's kind
+// is not supported by EachDo.
+func (t *Table) All(opts ...EachOption) iter.Seq2[*Table, *Table] {
+	return func(yield func(k, v *Table) bool) {
+		_ = t.each("Table.All", newEachOptions(opts), yield)
+	}
+}
+
+// Keys returns an iterator over keys of t, like All.
+func (t *Table) Keys(opts ...EachOption) iter.Seq[*Table] {
+	return func(yield func(k *Table) bool) {
+		_ = t.each("Table.Keys", newEachOptions(opts), func(k, _ *Table) bool {
+			return yield(k)
+		})
+	}
+}
+
+// Values returns an iterator over values of t, like All.
+func (t *Table) Values(opts ...EachOption) iter.Seq[*Table] {
+	return func(yield func(v *Table) bool) {
+		_ = t.each("Table.Values", newEachOptions(opts), func(_, v *Table) bool {
+			return yield(v)
+		})
+	}
+}
+
+// WalkSeq returns an iterator over paths and nodes of t, in the order of Walk.
+func (t *Table) WalkSeq(opts ...WalkOption) iter.Seq2[Path, *Table] {
+	return func(yield func(p Path, v *Table) bool) {
+		t.Walk(func(p Path, v *Table) WalkAction {
+			if !yield(p, v) {
+				return Stop
+			}
+			return Continue
+		}, opts...)
+	}
+}
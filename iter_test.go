@@ -0,0 +1,62 @@
+package table
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Iterators", func() {
+	Specify("with All()", func() {
+		m := map[string]int{"b": 2, "a": 1}
+		var ks []string
+		var vs []int
+		for k, v := range New(m).All() {
+			ks = append(ks, k.Interface().(string))
+			vs = append(vs, v.MustInt())
+		}
+		gomega.Expect(ks).Should(gomega.Equal([]string{"a", "b"}))
+		gomega.Expect(vs).Should(gomega.Equal([]int{1, 2}))
+	})
+	Specify("with Keys() and Values()", func() {
+		s := []string{"a", "b"}
+		var ks []int
+		for k := range New(s).Keys() {
+			ks = append(ks, k.MustInt())
+		}
+		gomega.Expect(ks).Should(gomega.Equal([]int{0, 1}))
+
+		var vs []string
+		for v := range New(s).Values() {
+			vs = append(vs, v.Interface().(string))
+		}
+		gomega.Expect(vs).Should(gomega.Equal(s))
+	})
+	Specify("breaking out of a channel", func() {
+		c := make(chan int, 3)
+		c <- 1
+		c <- 2
+		c <- 3
+		for v := range New(c).Values() {
+			gomega.Expect(v.MustInt()).Should(gomega.Equal(1))
+			break
+		}
+		gomega.Expect(<-c).Should(gomega.Equal(2))
+	})
+	Specify("with WalkSeq()", func() {
+		var ps []string
+		for p := range New([]interface{}{1, []int{2}}).WalkSeq() {
+			ps = append(ps, p.String())
+			if p.String() == "1" {
+				break
+			}
+		}
+		gomega.Expect(ps).Should(gomega.Equal([]string{"", "0", "1"}))
+	})
+	Specify("of other kind", func() {
+		n := 0
+		for range New(func() {}).All() {
+			n++
+		}
+		gomega.Expect(n).Should(gomega.Equal(0))
+	})
+})
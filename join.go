@@ -0,0 +1,275 @@
+package table
+
+import (
+	"math"
+	"reflect"
+)
+
+// JoinKind is the kind of a Join.
+type JoinKind int
+
+// kinds of joins, as of SQL.
+const (
+	// InnerJoin keeps only the matched records.
+	InnerJoin JoinKind = iota
+	// LeftJoin also keeps the unmatched records of the left.
+	LeftJoin
+	// RightJoin also keeps the unmatched records of the right.
+	RightJoin
+	// FullJoin also keeps the unmatched records of both sides.
+	FullJoin
+)
+
+func (k JoinKind) String() string {
+	switch k {
+	case InnerJoin:
+		return "inner"
+	case LeftJoin:
+		return "left"
+	case RightJoin:
+		return "right"
+	case FullJoin:
+		return "full"
+	default:
+		return "unknown"
+	}
+}
+
+// JoinOption configures Join.
+//
+// The options decide what happens when both records of a match have the same
+// field, by default the value of the left is kept.
+type JoinOption func(*joinOptions)
+
+type joinOptions struct {
+	collide func(m map[string]interface{}, field string, l, r interface{}) error
+}
+
+// JoinPreferRight keeps the value of the right when both sides have a field.
+func JoinPreferRight() JoinOption {
+	return func(o *joinOptions) {
+		o.collide = func(m map[string]interface{}, field string, l, r interface{}) error {
+			m[field] = r
+			return nil
+		}
+	}
+}
+
+// JoinPrefix keeps both values when both sides have a field,
+// as the field prefixed by left and by right.
+func JoinPrefix(left, right string) JoinOption {
+	return func(o *joinOptions) {
+		o.collide = func(m map[string]interface{}, field string, l, r interface{}) error {
+			delete(m, field)
+			m[left+field] = l
+			m[right+field] = r
+			return nil
+		}
+	}
+}
+
+// JoinStrict returns ErrConflict when both sides have a field of unequal values,
+// numbers of any kinds are compared by value.
+func JoinStrict() JoinOption {
+	return func(o *joinOptions) {
+		o.collide = func(m map[string]interface{}, field string, l, r interface{}) error {
+			if !valueEqual(reflect.ValueOf(l), reflect.ValueOf(r)) {
+				return &ErrConflict{"table.Join", field}
+			}
+			return nil
+		}
+	}
+}
+
+// JoinResolve keeps what f returns when both sides have a field.
+func JoinResolve(f func(field string, l, r interface{}) (interface{}, error)) JoinOption {
+	return func(o *joinOptions) {
+		o.collide = func(m map[string]interface{}, field string, l, r interface{}) error {
+			x, err := f(field, l, r)
+			if err != nil {
+				return err
+			}
+			m[field] = x
+			return nil
+		}
+	}
+}
+
+// Join joins the records of left and right, arrays or slices of maps, structs
+// or pointers to them, whose values at leftKey and rightKey, as of GetPath,
+// are equal. Numbers of any kinds are compared by value, and a missing or nil
+// key matches nothing.
+//
+// It returns the merged fields of the records of every match, in the order of
+// the left, and the unmatched records kept by kind, as is, after them in the
+// order of the right.
+// It returns error if a record is not a map or struct, or a key is not comparable.
+func Join(left, right *Table, leftKey, rightKey string, kind JoinKind, opts ...JoinOption) ([]map[string]interface{}, error) {
+	o := &joinOptions{
+		collide: func(m map[string]interface{}, field string, l, r interface{}) error {
+			return nil
+		},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	ls, lks, err := joinSide(left, leftKey)
+	if err != nil {
+		return nil, err
+	}
+	rs, rks, err := joinSide(right, rightKey)
+	if err != nil {
+		return nil, err
+	}
+
+	index := map[interface{}][]int{}
+	for i, k := range rks {
+		if k != nil {
+			index[k] = append(index[k], i)
+		}
+	}
+
+	var rows []map[string]interface{}
+	matched := make([]bool, len(rs))
+	for i, l := range ls {
+		var js []int
+		if lks[i] != nil {
+			js = index[lks[i]]
+		}
+		if len(js) == 0 && (kind == LeftJoin || kind == FullJoin) {
+			rows = append(rows, joinRow(l, nil))
+		}
+		for _, j := range js {
+			matched[j] = true
+			row := joinRow(l, nil)
+			for field, rv := range rs[j] {
+				lv, ok := l[field]
+				if !ok {
+					row[field] = rv
+					continue
+				}
+				if err := o.collide(row, field, lv, rv); err != nil {
+					return nil, err
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	if kind == RightJoin || kind == FullJoin {
+		for j, r := range rs {
+			if !matched[j] {
+				rows = append(rows, joinRow(nil, r))
+			}
+		}
+	}
+	return rows, nil
+}
+
+// joinRow returns a copy of the fields of l and r.
+func joinRow(l, r map[string]interface{}) map[string]interface{} {
+	row := make(map[string]interface{}, len(l)+len(r))
+	for k, v := range l {
+		row[k] = v
+	}
+	for k, v := range r {
+		row[k] = v
+	}
+	return row
+}
+
+// joinSide returns the fields and the join keys of the records of t,
+// nil records are passed.
+func joinSide(t *Table, key string) ([]map[string]interface{}, []interface{}, error) {
+	_, recs, err := t.records("table.Join")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rs []map[string]interface{}
+	var ks []interface{}
+	for _, rec := range recs {
+		if !indirect(rec.getv()).IsValid() {
+			continue
+		}
+		fields, err := rec.fields()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		kv, err := rec.GetPath(key)
+		if err != nil {
+			return nil, nil, rec.mustErr(err)
+		}
+		var jk interface{}
+		if kv != nil {
+			if jk, err = hashKey("table.Join", indirect(kv.getv())); err != nil {
+				return nil, nil, kv.mustErr(err)
+			}
+		}
+		rs = append(rs, fields)
+		ks = append(ks, jk)
+	}
+	return rs, ks, nil
+}
+
+// fields returns the fields of the record t, a map of string keys or a struct.
+func (t *Table) fields() (map[string]interface{}, error) {
+	m, err := t.Map()
+	if err != nil {
+		return nil, t.mustErr(err)
+	}
+	fields := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if !v.getv().CanInterface() {
+			continue
+		}
+		name, err := k.String()
+		if err != nil {
+			return nil, t.mustErr(err)
+		}
+		fields[name] = v.Interface()
+	}
+	return fields, nil
+}
+
+// nanKey is the key of NaN by hashKey, so NaNs are the same key.
+type nanKey struct{}
+
+// hashKey returns v as a map key, numbers equal by value are the same key,
+// and values of named bool, string or complex types are of their base types.
+// It returns the nil for the invalid v.
+func hashKey(method string, v reflect.Value) (interface{}, error) {
+	switch {
+	case !v.IsValid():
+		return nil, nil
+	case isIntKind(v.Kind()):
+		return v.Int(), nil
+	case isUintKind(v.Kind()):
+		if u := v.Uint(); u > math.MaxInt64 {
+			return u, nil
+		}
+		return int64(v.Uint()), nil
+	case v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) {
+			return nanKey{}, nil
+		}
+		if f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+			return int64(f), nil
+		}
+		return f, nil
+	case v.Kind() == reflect.Bool:
+		return v.Bool(), nil
+	case v.Kind() == reflect.String:
+		return v.String(), nil
+	case isComplexKind(v.Kind()):
+		return v.Complex(), nil
+	case !v.Comparable() || !v.CanInterface():
+		// Comparable sees the dynamic values of interfaces too
+		return nil, &ErrUnsupportedKind{method, v.Kind()}
+	default:
+		return v.Interface(), nil
+	}
+}
@@ -0,0 +1,102 @@
+package table
+
+import (
+	"math"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Join", func() {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{1, "a"}, {2, "b"}, {3, "c"}}
+	orders := []map[string]interface{}{
+		{"user": 1.0, "item": "x"},
+		{"user": uint(1), "item": "y"},
+		{"user": 4, "item": "z"},
+		{"item": "w"},
+	}
+
+	Specify("inner", func() {
+		rows, err := Join(New(users), New(orders), "ID", "user", InnerJoin)
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(rows).Should(gomega.Equal([]map[string]interface{}{
+			{"ID": 1, "Name": "a", "user": 1.0, "item": "x"},
+			{"ID": 1, "Name": "a", "user": uint(1), "item": "y"},
+		}))
+	})
+	Specify("left", func() {
+		rows, err := Join(New(users), New(orders), "ID", "user", LeftJoin)
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(rows).Should(gomega.HaveLen(4))
+		gomega.Expect(rows[2]).Should(gomega.Equal(map[string]interface{}{"ID": 2, "Name": "b"}))
+	})
+	Specify("right", func() {
+		rows, err := Join(New(users), New(orders), "ID", "user", RightJoin)
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(rows).Should(gomega.HaveLen(4))
+		gomega.Expect(rows[2:]).Should(gomega.Equal([]map[string]interface{}{orders[2], orders[3]}))
+	})
+	Specify("full", func() {
+		rows, err := Join(New(&users), New(orders), "ID", "user", FullJoin)
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(rows).Should(gomega.HaveLen(6))
+	})
+
+	Context("with collision", func() {
+		l := []map[string]interface{}{{"id": 1, "v": "l"}}
+		r := []map[string]interface{}{{"id": 1, "v": "r"}}
+		Specify("prefer left", func() {
+			gomega.Expect(Join(New(l), New(r), "id", "id", InnerJoin)).
+				Should(gomega.Equal([]map[string]interface{}{{"id": 1, "v": "l"}}))
+		})
+		Specify("prefer right", func() {
+			gomega.Expect(Join(New(l), New(r), "id", "id", InnerJoin, JoinPreferRight())).
+				Should(gomega.Equal([]map[string]interface{}{{"id": 1, "v": "r"}}))
+		})
+		Specify("prefix", func() {
+			gomega.Expect(Join(New(l), New(r), "id", "id", InnerJoin, JoinPrefix("l.", "r."))).
+				Should(gomega.Equal([]map[string]interface{}{{"l.id": 1, "r.id": 1, "l.v": "l", "r.v": "r"}}))
+		})
+		Specify("strict", func() {
+			ExpectErr(Join(New(l), New(r), "id", "id", InnerJoin, JoinStrict())).
+				Should(gomega.Equal(&ErrConflict{"table.Join", "v"}))
+		})
+		Specify("resolve", func() {
+			cat := JoinResolve(func(field string, l, r interface{}) (interface{}, error) {
+				return []interface{}{l, r}, nil
+			})
+			gomega.Expect(Join(New(l), New(r), "id", "id", InnerJoin, cat)).
+				Should(gomega.Equal([]map[string]interface{}{{"id": []interface{}{1, 1}, "v": []interface{}{"l", "r"}}}))
+		})
+	})
+
+	Specify("uncomparable key", func() {
+		l := []map[string]interface{}{{"id": []int{1}}}
+		_, err := Join(New(l), New(l), "id", "id", InnerJoin)
+		gomega.Expect(err).Should(gomega.HaveOccurred())
+	})
+	Specify("named string and NaN keys", func() {
+		type id string
+		l := []map[string]interface{}{{"id": id("a"), "v": 1}, {"id": math.NaN(), "v": 2}}
+		r := []map[string]interface{}{{"key": "a", "w": 3}, {"key": math.NaN(), "w": 4}}
+		rows, err := Join(New(l), New(r), "id", "key", InnerJoin)
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(rows).Should(gomega.HaveLen(2))
+		gomega.Expect(rows[0]["w"]).Should(gomega.Equal(3))
+		gomega.Expect(rows[1]["w"]).Should(gomega.Equal(4))
+	})
+	Specify("key of an uncomparable value in an interface", func() {
+		type key struct{ V interface{} }
+		l := []map[string]interface{}{{"id": key{map[string]int{}}}}
+		_, err := Join(New(l), New(l), "id", "id", InnerJoin)
+		gomega.Expect(err).Should(gomega.Equal(&ErrPath{Path{0, "id"}, &ErrUnsupportedKind{"table.Join", reflect.Struct}}))
+	})
+	Specify("kind string", func() {
+		gomega.Expect(FullJoin.String()).Should(gomega.Equal("full"))
+	})
+})
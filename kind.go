@@ -0,0 +1,193 @@
+package table
+
+import (
+	"reflect"
+)
+
+// Kind is the simplified, JSON-ish kind of a value.
+type Kind int
+
+const (
+	// KindNull is the kind of nil values.
+	KindNull Kind = iota
+	// KindBool is the kind of bools.
+	KindBool
+	// KindNumber is the kind of ints, uints, floats and complexes.
+	KindNumber
+	// KindString is the kind of strings.
+	KindString
+	// KindArray is the kind of arrays and slices.
+	KindArray
+	// KindObject is the kind of maps and structs.
+	KindObject
+	// KindOther is the kind of channels, functions and unsafe pointers.
+	KindOther
+)
+
+var kindNames = [...]string{
+	KindNull:   "null",
+	KindBool:   "bool",
+	KindNumber: "number",
+	KindString: "string",
+	KindArray:  "array",
+	KindObject: "object",
+	KindOther:  "other",
+}
+
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return "invalid"
+	}
+	return kindNames[k]
+}
+
+// value returns t's underlying value, seeing through interfaces and pointers.
+// It's invalid if t is the nil *Table or carries an error.
+func (t *Table) value() reflect.Value {
+	if t.check("Table.value") != nil {
+		return reflect.Value{}
+	}
+	return indirect(t.getv())
+}
+
+// Kind returns the kind of t's underlying value,
+// seeing through interfaces and pointers like Get does.
+func (t *Table) Kind() Kind {
+	return valueKind(t.value())
+}
+
+// valueKind returns the kind of the indirected value v.
+func valueKind(v reflect.Value) Kind {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return KindNull
+	case reflect.Bool:
+		return KindBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return KindNumber
+	case reflect.String:
+		return KindString
+	case reflect.Array, reflect.Slice:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return KindNull
+		}
+		return KindArray
+	case reflect.Map:
+		if v.IsNil() {
+			return KindNull
+		}
+		return KindObject
+	case reflect.Struct:
+		return KindObject
+	default:
+		return KindOther
+	}
+}
+
+// ReflectKind returns the reflect kind of t's underlying value,
+// seeing through interfaces and pointers.
+// It's reflect.Invalid for nil values.
+func (t *Table) ReflectKind() reflect.Kind {
+	return t.value().Kind()
+}
+
+// Type returns the type of t's underlying value,
+// seeing through interfaces and pointers.
+// It's the nil for nil values.
+func (t *Table) Type() reflect.Type {
+	v := t.value()
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Type()
+}
+
+// Underlying returns t's underlying value,
+// seeing through interfaces and pointers.
+func (t *Table) Underlying() interface{} {
+	v := t.value()
+	if !v.IsValid() || !v.CanInterface() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// IsNil reports whether t's underlying value is nil,
+// or a nil map, slice, channel or function after seeing through
+// interfaces and pointers.
+// The nil *Table is nil.
+func (t *Table) IsNil() bool {
+	v := t.value()
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// IsZero reports whether t's underlying value is the zero value of its type,
+// seeing through interfaces and pointers. Nil values are zero.
+func (t *Table) IsZero() bool {
+	v := t.value()
+	return !v.IsValid() || v.IsZero()
+}
+
+// IsEmpty reports whether t's underlying value is nil, an empty map, array,
+// slice, string or channel, or a zero scalar.
+// A struct is never empty.
+func (t *Table) IsEmpty() bool {
+	v := t.value()
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Map, reflect.Array, reflect.Slice, reflect.String, reflect.Chan:
+		return v.Len() == 0
+	case reflect.Struct:
+		return false
+	default:
+		return v.IsZero()
+	}
+}
+
+// Len returns the length of t's underlying value, seeing through
+// interfaces and pointers.
+// The length of a struct is its number of fields, as of Map and Slice.
+// It returns -1 if t's kind is not Map, Array, Slice, String, Chan or Struct.
+func (t *Table) Len() int {
+	v := t.value()
+	switch v.Kind() {
+	case reflect.Map, reflect.Array, reflect.Slice, reflect.String, reflect.Chan:
+		return v.Len()
+	case reflect.Struct:
+		return v.NumField()
+	default:
+		return -1
+	}
+}
+
+// IsContainer reports whether t's underlying value is a map, array, slice
+// or struct, seeing through interfaces and pointers.
+func (t *Table) IsContainer() bool {
+	switch t.ReflectKind() {
+	case reflect.Map, reflect.Array, reflect.Slice, reflect.Struct:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsScalar reports whether t's underlying value is a bool, number or string,
+// seeing through interfaces and pointers.
+func (t *Table) IsScalar() bool {
+	switch t.Kind() {
+	case KindBool, KindNumber, KindString:
+		return true
+	default:
+		return false
+	}
+}
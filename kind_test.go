@@ -0,0 +1,68 @@
+package table
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Introspection", func() {
+	i := 1
+	var np *int
+	var nm map[string]int
+
+	Specify("with Kind() and ReflectKind()", func() {
+		cases := map[*Table]Kind{
+			New(nil):                    KindNull,
+			New(np):                     KindNull,
+			New(nm):                     KindNull,
+			New(true):                   KindBool,
+			New(&i):                     KindNumber,
+			New(1.5):                    KindNumber,
+			New("s"):                    KindString,
+			New([]int{}):                KindArray,
+			New([1]int{}):               KindArray,
+			New(map[string]int{}):       KindObject,
+			New(struct{}{}):             KindObject,
+			New(make(chan int)):         KindOther,
+			New(map[string]int{}).At(1): KindNull,
+		}
+		for t, k := range cases {
+			gomega.Expect(t.Kind()).To(gomega.Equal(k), "%v", t.Interface())
+		}
+		gomega.Expect(New(&i).ReflectKind()).To(gomega.Equal(reflect.Int))
+		gomega.Expect(New(nil).ReflectKind()).To(gomega.Equal(reflect.Invalid))
+		gomega.Expect(KindObject.String()).To(gomega.Equal("object"))
+	})
+	Specify("with Type() and Underlying()", func() {
+		gomega.Expect(New(&i).Type()).To(gomega.Equal(reflect.TypeOf(0)))
+		gomega.Expect(New(nil).Type()).To(gomega.BeNil())
+		gomega.Expect(New(&i).Underlying()).To(gomega.Equal(1))
+	})
+	Specify("with IsNil(), IsZero() and IsEmpty()", func() {
+		gomega.Expect(New(np).IsNil()).To(gomega.BeTrue())
+		gomega.Expect(New(nm).IsNil()).To(gomega.BeTrue())
+		gomega.Expect(New(0).IsNil()).To(gomega.BeFalse())
+
+		gomega.Expect(New(0).IsZero()).To(gomega.BeTrue())
+		gomega.Expect(New(struct{ A int }{}).IsZero()).To(gomega.BeTrue())
+		gomega.Expect(New(&i).IsZero()).To(gomega.BeFalse())
+
+		gomega.Expect(New("").IsEmpty()).To(gomega.BeTrue())
+		gomega.Expect(New([]int{}).IsEmpty()).To(gomega.BeTrue())
+		gomega.Expect(New(struct{}{}).IsEmpty()).To(gomega.BeFalse())
+		gomega.Expect(New([]int{1}).IsEmpty()).To(gomega.BeFalse())
+	})
+	Specify("with Len(), IsContainer() and IsScalar()", func() {
+		gomega.Expect(New([]int{1, 2}).Len()).To(gomega.Equal(2))
+		gomega.Expect(New("abc").Len()).To(gomega.Equal(3))
+		gomega.Expect(New(struct{ A, B int }{}).Len()).To(gomega.Equal(2))
+		gomega.Expect(New(1).Len()).To(gomega.Equal(-1))
+
+		gomega.Expect(New(map[int]int{}).IsContainer()).To(gomega.BeTrue())
+		gomega.Expect(New("s").IsContainer()).To(gomega.BeFalse())
+		gomega.Expect(New("s").IsScalar()).To(gomega.BeTrue())
+		gomega.Expect(New(nil).IsScalar()).To(gomega.BeFalse())
+	})
+})
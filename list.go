@@ -0,0 +1,268 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ListOption configures FromAList and FromPList.
+type ListOption func(*listOptions)
+
+type listOptions struct {
+	typ reflect.Type
+}
+
+// ListType makes FromAList and FromPList build a value of the type typ,
+// which is a map, struct, array or slice type.
+func ListType(typ reflect.Type) ListOption {
+	return func(o *listOptions) {
+		o.typ = typ
+	}
+}
+
+// FromAList returns a Table of the value built from the association list alist,
+// it's the reverse of AList.
+//
+// Without ListType, it builds a slice if the keys are dense int indexes from 0,
+// otherwise a map. The key and value types are the common types of the keys
+// and values, or interface{} if they differ. So the type of the value AList
+// is of isn't always kept: a map of the keys 0 to n-1 or an array becomes a
+// slice, and an empty one becomes a map[interface{}]interface{}, pass ListType
+// of its type to keep it. With ListType, keys are converted to the map's key
+// type, names of the struct's fields, or indexes of the array or slice, and
+// values are converted like ConvTo does. Unexported struct fields are passed
+// and left zero, as they can't be set.
+//
+// It returns ErrConflict if a key is given twice.
+func FromAList(alist [][2]*Table, opts ...ListOption) (*Table, error) {
+	o := &listOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	keys := make([]*Table, len(alist))
+	vals := make([]*Table, len(alist))
+	for i, kv := range alist {
+		keys[i], vals[i] = kv[0], kv[1]
+		if vals[i] == nil {
+			vals[i] = New(nil)
+		}
+	}
+	return fromList("table.FromAList", keys, vals, o)
+}
+
+// FromPList returns a Table of the value built from the property list plist,
+// it's the reverse of PList. It's the same as FromAList of the paired elements.
+// It returns ErrOutOfRange if plist has an odd length.
+func FromPList(plist []*Table, opts ...ListOption) (*Table, error) {
+	if len(plist)%2 != 0 {
+		return nil, &ErrOutOfRange{"table.FromPList"}
+	}
+
+	alist := make([][2]*Table, len(plist)/2)
+	for i := range alist {
+		alist[i] = [2]*Table{plist[2*i], plist[2*i+1]}
+	}
+	return FromAList(alist, opts...)
+}
+
+func fromList(method string, keys, vals []*Table, o *listOptions) (*Table, error) {
+	typ := o.typ
+	if typ == nil {
+		if isDenseIndexes(keys) {
+			typ = reflect.SliceOf(commonType(vals))
+		} else {
+			typ = reflect.MapOf(commonType(keys), commonType(vals))
+		}
+	}
+
+	var (
+		v   reflect.Value
+		err error
+	)
+	switch typ.Kind() {
+	case reflect.Map:
+		v, err = mapFromList(method, typ, keys, vals)
+	case reflect.Struct:
+		v, err = structFromList(method, typ, keys, vals)
+	case reflect.Array, reflect.Slice:
+		v, err = sliceFromList(method, typ, keys, vals)
+	default:
+		return nil, &ErrUnsupportedKind{method, typ.Kind()}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Table{v: v}, nil
+}
+
+func mapFromList(method string, typ reflect.Type, keys, vals []*Table) (reflect.Value, error) {
+	if !typ.Key().Comparable() {
+		return reflect.Value{}, &ErrUnsupportedKind{method, "map of " + typ.Key().String() + " key"}
+	}
+
+	m := reflect.MakeMapWithSize(typ, len(keys))
+	for i, k := range keys {
+		kv, err := listKey(k, typ.Key())
+		if err != nil {
+			return m, err
+		}
+		if m.MapIndex(kv).IsValid() {
+			return m, &ErrConflict{method, fmt.Sprint(kv) + " key"}
+		}
+
+		ev := reflect.New(typ.Elem()).Elem()
+		if err := setListValue(ev, vals[i]); err != nil {
+			return m, err
+		}
+		m.SetMapIndex(kv, ev)
+	}
+	return m, nil
+}
+
+func structFromList(method string, typ reflect.Type, keys, vals []*Table) (reflect.Value, error) {
+	s := reflect.New(typ).Elem()
+	set := map[string]bool{}
+	for i, k := range keys {
+		name, err := k.String()
+		if err != nil {
+			return s, err
+		}
+		if set[name] {
+			return s, &ErrConflict{method, name + " field"}
+		}
+		set[name] = true
+
+		f, ok := structLookup(s, name)
+		if !ok {
+			return s, &ErrNotExist{method, name + " field"}
+		}
+		if !f.CanSet() {
+			// unexported, as AList passes them
+			continue
+		}
+		if err := setListValue(f, vals[i]); err != nil {
+			return s, err
+		}
+	}
+	return s, nil
+}
+
+func sliceFromList(method string, typ reflect.Type, keys, vals []*Table) (reflect.Value, error) {
+	idxs := make([]int, len(keys))
+	l := 0
+	for i, k := range keys {
+		kv, err := listKey(k, reflect.TypeOf(0))
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		idx := int(kv.Int())
+		if idx < 0 {
+			return reflect.Value{}, &ErrOutOfRange{method}
+		}
+		idxs[i] = idx
+		if idx >= l {
+			l = idx + 1
+		}
+	}
+
+	var s reflect.Value
+	if typ.Kind() == reflect.Array {
+		if l > typ.Len() {
+			return s, &ErrOutOfRange{method}
+		}
+		s = reflect.New(typ).Elem()
+	} else {
+		s = reflect.MakeSlice(typ, l, l)
+	}
+
+	set := make([]bool, l)
+	for i, idx := range idxs {
+		if set[idx] {
+			return s, &ErrConflict{method, fmt.Sprint(idx) + " index"}
+		}
+		set[idx] = true
+		if err := setListValue(s.Index(idx), vals[i]); err != nil {
+			return s, err
+		}
+	}
+	return s, nil
+}
+
+// setListValue sets dst to the value of v as is if it's assignable,
+// otherwise converted like ConvTo does.
+func setListValue(dst reflect.Value, v *Table) error {
+	if err := v.check("table.setListValue"); err != nil {
+		return err
+	}
+	if vv, ok := assignable(v.getv(), dst.Type()); ok {
+		dst.Set(vv)
+		return nil
+	}
+	return v.convTo(dst)
+}
+
+// listKey returns the key k as a value of the type typ.
+func listKey(k *Table, typ reflect.Type) (reflect.Value, error) {
+	if err := k.check("table.listKey"); err != nil {
+		return reflect.Value{}, err
+	}
+	if kv, ok := convKey(k.geti(), typ); ok {
+		return kv, nil
+	}
+	kv := reflect.New(typ).Elem()
+	err := k.convTo(kv)
+	return kv, err
+}
+
+// isDenseIndexes reports whether keys are the ints 0 to len(keys)-1 in any order.
+func isDenseIndexes(keys []*Table) bool {
+	if len(keys) == 0 {
+		return false
+	}
+	seen := make([]bool, len(keys))
+	for _, k := range keys {
+		if k == nil {
+			return false
+		}
+		idx, ok := k.geti().(int)
+		if !ok || idx < 0 || idx >= len(keys) || seen[idx] {
+			return false
+		}
+		seen[idx] = true
+	}
+	return true
+}
+
+// commonType returns the type of the values of ts if it's the same for all
+// of them, otherwise interface{}.
+// Nil values don't count, if they can be of the type.
+func commonType(ts []*Table) reflect.Type {
+	var typ reflect.Type
+	hasNil := false
+	for _, t := range ts {
+		if t == nil {
+			hasNil = true
+			continue
+		}
+		v := t.getv()
+		if !v.IsValid() {
+			hasNil = true
+			continue
+		}
+		if typ == nil {
+			typ = v.Type()
+		} else if typ != v.Type() {
+			return _InterfaceType
+		}
+	}
+	if typ == nil {
+		return _InterfaceType
+	}
+	if hasNil {
+		if _, ok := assignable(reflect.Value{}, typ); !ok {
+			return _InterfaceType
+		}
+	}
+	return typ
+}
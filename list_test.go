@@ -0,0 +1,117 @@
+package table
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("From lists", func() {
+	type s struct {
+		A int
+		B string `table:"b"`
+	}
+
+	Context("round-trips", func() {
+		xs := []interface{}{
+			map[string]int{"a": 1, "b": 2},
+			map[string]interface{}{"a": 1, "b": "x", "c": nil},
+			map[int][]string{1: {"a"}, 3: nil},
+			[]string{"a", "b"},
+			[]interface{}{1, "a", nil},
+		}
+		Specify("with AList()", func() {
+			for _, x := range xs {
+				t, err := FromAList(New(x).MustAList())
+				gomega.Expect(err).Should(gomega.BeNil())
+				gomega.Expect(t.Interface()).Should(gomega.Equal(x))
+			}
+		})
+		Specify("with PList()", func() {
+			for _, x := range xs {
+				t, err := FromPList(New(x).MustPList())
+				gomega.Expect(err).Should(gomega.BeNil())
+				gomega.Expect(t.Interface()).Should(gomega.Equal(x))
+			}
+		})
+		Specify("of struct and array", func() {
+			x := s{1, "b"}
+			t, err := FromAList(New(x).MustAList(), ListType(reflect.TypeOf(x)))
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(t.Interface()).Should(gomega.Equal(x))
+
+			a := [3]int{1, 2, 3}
+			t, err = FromPList(New(a).MustPList(), ListType(reflect.TypeOf(a)))
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(t.Interface()).Should(gomega.Equal(a))
+		})
+		Specify("of struct with unexported fields", func() {
+			type u struct {
+				A int
+				b string
+				c []int
+			}
+			x := u{1, "b", []int{1}}
+			t, err := FromAList(New(x).MustAList(), ListType(reflect.TypeOf(x)))
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(t.Interface()).Should(gomega.Equal(u{A: 1}))
+
+			t, err = FromPList(New(&x).MustPList(), ListType(reflect.TypeOf(x)))
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(t.Interface()).Should(gomega.Equal(u{A: 1}))
+		})
+		Specify("of types not kept without ListType", func() {
+			cases := []struct{ x, without interface{} }{
+				{map[int]string{0: "a", 1: "b"}, []string{"a", "b"}},
+				{[2]int{1, 2}, []int{1, 2}},
+				{[]int{}, map[interface{}]interface{}{}},
+				{map[string]int{}, map[interface{}]interface{}{}},
+			}
+			for _, c := range cases {
+				alist := New(c.x).MustAList()
+				t, err := FromAList(alist)
+				gomega.Expect(err).Should(gomega.BeNil())
+				gomega.Expect(t.Interface()).Should(gomega.Equal(c.without))
+
+				t, err = FromAList(alist, ListType(reflect.TypeOf(c.x)))
+				gomega.Expect(err).Should(gomega.BeNil())
+				gomega.Expect(t.Interface()).Should(gomega.Equal(c.x))
+			}
+		})
+	})
+	Specify("with mixed types", func() {
+		t, err := FromPList([]*Table{New("a"), New(1), New(2), New("b")})
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(t.Interface()).Should(gomega.Equal(map[interface{}]interface{}{"a": 1, 2: "b"}))
+	})
+	Specify("with converted keys and values", func() {
+		alist := [][2]*Table{{New("1"), New(int8(1))}, {New("2"), New(2)}}
+		t, err := FromAList(alist, ListType(reflect.TypeOf(map[int]int64{})))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(t.Interface()).Should(gomega.Equal(map[int]int64{1: 1, 2: 2}))
+
+		t, err = FromAList([][2]*Table{{New("b"), New("x")}}, ListType(reflect.TypeOf(s{})))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(t.Interface()).Should(gomega.Equal(s{B: "x"}))
+	})
+	Specify("with errors", func() {
+		_, err := FromPList([]*Table{New("a"), New(1), New("a"), New(2)})
+		gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrConflict)(nil)))
+
+		_, err = FromPList([]*Table{New(0), New(1), New(0), New(2)}, ListType(reflect.TypeOf([]int{})))
+		gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrConflict)(nil)))
+
+		_, err = FromPList([]*Table{New("a"), New(1), New("b")})
+		gomega.Expect(err).Should(gomega.Equal(&ErrOutOfRange{"table.FromPList"}))
+
+		_, err = FromPList([]*Table{New("C"), New(1)}, ListType(reflect.TypeOf(s{})))
+		gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrNotExist)(nil)))
+
+		_, err = FromPList([]*Table{New(5), New(1)}, ListType(reflect.TypeOf([2]int{})))
+		gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrOutOfRange)(nil)))
+
+		_, err = FromPList([]*Table{New(1), New(1)}, ListType(reflect.TypeOf(1)))
+		gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+	})
+})
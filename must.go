@@ -1,109 +1,272 @@
+// Code generated by gen_must.go; DO NOT EDIT.
+
 package table
 
-// MustInt must api for Int()
+// MustGet must api for Get
+func (t *Table) MustGet(k interface{}) *Table {
+	v, err := t.Get(k)
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustGetPath must api for GetPath
+func (t *Table) MustGetPath(path interface{}) *Table {
+	v, err := t.GetPath(path)
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustSet must api for Set
+func (t *Table) MustSet(v interface{}) {
+	if err := t.Set(v); err != nil {
+		panic(t.mustErr(err))
+	}
+}
+
+// MustPut must api for Put
+func (t *Table) MustPut(k, v interface{}) {
+	if err := t.Put(k, v); err != nil {
+		panic(t.mustErr(err))
+	}
+}
+
+// MustInsert must api for Insert
+func (t *Table) MustInsert(idx int, v interface{}) {
+	if err := t.Insert(idx, v); err != nil {
+		panic(t.mustErr(err))
+	}
+}
+
+// MustDelete must api for Delete
+func (t *Table) MustDelete(idx int) {
+	if err := t.Delete(idx); err != nil {
+		panic(t.mustErr(err))
+	}
+}
+
+// MustWith must api for With
+func (t *Table) MustWith(path, value interface{}) *Table {
+	v, err := t.With(path, value)
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustWithout must api for Without
+func (t *Table) MustWithout(path interface{}) *Table {
+	v, err := t.Without(path)
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustMerge must api for Merge
+func (t *Table) MustMerge(o *Table) *Table {
+	v, err := t.Merge(o)
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustConvTo must api for ConvTo
+func (t *Table) MustConvTo(value interface{}) {
+	if err := t.ConvTo(value); err != nil {
+		panic(t.mustErr(err))
+	}
+}
+
+// MustBytes must api for Bytes
+func (t *Table) MustBytes() []byte {
+	v, err := t.Bytes()
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustBool must api for Bool
+func (t *Table) MustBool() bool {
+	v, err := t.Bool()
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustInt must api for Int
 func (t *Table) MustInt() int {
-	i, err := t.Int()
+	v, err := t.Int()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return i
+	return v
 }
 
-// MustInt8 must api for Int8()
+// MustInt8 must api for Int8
 func (t *Table) MustInt8() int8 {
-	i, err := t.Int8()
+	v, err := t.Int8()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return i
+	return v
 }
 
-// MustInt16 must api for Int16()
+// MustInt16 must api for Int16
 func (t *Table) MustInt16() int16 {
-	i, err := t.Int16()
+	v, err := t.Int16()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return i
+	return v
 }
 
-// MustInt32 must api for Int32()
+// MustInt32 must api for Int32
 func (t *Table) MustInt32() int32 {
-	i, err := t.Int32()
+	v, err := t.Int32()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return i
+	return v
 }
 
-// MustInt64 must api for Int64()
+// MustInt64 must api for Int64
 func (t *Table) MustInt64() int64 {
-	i, err := t.Int64()
+	v, err := t.Int64()
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustUint must api for Uint
+func (t *Table) MustUint() uint {
+	v, err := t.Uint()
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustUint8 must api for Uint8
+func (t *Table) MustUint8() uint8 {
+	v, err := t.Uint8()
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustUint16 must api for Uint16
+func (t *Table) MustUint16() uint16 {
+	v, err := t.Uint16()
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustUint32 must api for Uint32
+func (t *Table) MustUint32() uint32 {
+	v, err := t.Uint32()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return i
+	return v
 }
 
-// MustFloat32 must api for Float32()
+// MustUint64 must api for Uint64
+func (t *Table) MustUint64() uint64 {
+	v, err := t.Uint64()
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustFloat32 must api for Float32
 func (t *Table) MustFloat32() float32 {
-	val, err := t.Float32()
+	v, err := t.Float32()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return val
+	return v
 }
 
-// MustFloat64 must api for Float64()
+// MustFloat64 must api for Float64
 func (t *Table) MustFloat64() float64 {
-	val, err := t.Float64()
+	v, err := t.Float64()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return val
+	return v
 }
 
-// MustGet must api for Get
-func (t *Table) MustGet(k interface{}) *Table {
-	val, err := t.Get(k)
+// MustComplex64 must api for Complex64
+func (t *Table) MustComplex64() complex64 {
+	v, err := t.Complex64()
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustComplex128 must api for Complex128
+func (t *Table) MustComplex128() complex128 {
+	v, err := t.Complex128()
+	if err != nil {
+		panic(t.mustErr(err))
+	}
+	return v
+}
+
+// MustString must api for String
+func (t *Table) MustString() string {
+	v, err := t.String()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return val
+	return v
 }
 
 // MustMap must api for Map
 func (t *Table) MustMap() map[*Table]*Table {
-	tm, err := t.Map()
+	v, err := t.Map()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return tm
+	return v
 }
 
 // MustSlice must api for Slice
 func (t *Table) MustSlice() []*Table {
-	ts, err := t.Slice()
+	v, err := t.Slice()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return ts
+	return v
 }
 
 // MustAList must api for AList
 func (t *Table) MustAList() [][2]*Table {
-	tl, err := t.AList()
+	v, err := t.AList()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return tl
+	return v
 }
 
 // MustPList must api for PList
 func (t *Table) MustPList() []*Table {
-	tl, err := t.PList()
+	v, err := t.PList()
 	if err != nil {
-		panic(err)
+		panic(t.mustErr(err))
 	}
-	return tl
+	return v
 }
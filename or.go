@@ -0,0 +1,92 @@
+package table
+
+import (
+	"math"
+	"time"
+)
+
+// Exists reports whether t holds a value, even a nil one.
+// It's false for the nil *Table, e.g. the not found result of Get,
+// and for a Table carrying an error, see At.
+func (t *Table) Exists() bool {
+	return t != nil && t.err == nil
+}
+
+// Has reports whether there is a value at path below t.
+// The path is as of GetPath.
+func (t *Table) Has(path interface{}) bool {
+	v, err := t.GetPath(path)
+	return err == nil && v.Exists()
+}
+
+// Or returns the value at path below t converted to T like As,
+// or def if it's not found or can't be converted to T, e.g. Or(t, "a.b", def).
+// Unlike IntOr, an integral float is not an int of it.
+// The path is as of GetPath.
+func Or[T any](t *Table, path interface{}, def T) T {
+	return GetOr(t, path, def)
+}
+
+// AsOr returns t's underlying value converted to T like As,
+// or def if t is the nil *Table or can't be converted to T.
+func AsOr[T any](t *Table, def T) T {
+	x, err := As[T](t)
+	if err != nil {
+		return def
+	}
+	return x
+}
+
+// IntOr returns the value at path below t as an int, or def if it's not found
+// or not an integer. An integral float, as decoded from JSON, is an integer.
+// The path is as of GetPath.
+func (t *Table) IntOr(path interface{}, def int) int {
+	v, err := t.GetPath(path)
+	if err != nil || v == nil {
+		return def
+	}
+	if i, err := v.Int(); err == nil {
+		return i
+	}
+	f, err := v.Float64()
+	// -MinInt is exact as a float64, MaxInt is rounded up to it
+	if err != nil || f != math.Trunc(f) || f < math.MinInt || f >= -math.MinInt {
+		return def
+	}
+	return int(f)
+}
+
+// StringOr returns the value at path below t as a string like String,
+// or def if it's not found.
+// The path is as of GetPath.
+func (t *Table) StringOr(path interface{}, def string) string {
+	return GetOr(t, path, def)
+}
+
+// BoolOr returns the value at path below t as a bool,
+// or def if it's not found or not a bool.
+// The path is as of GetPath.
+func (t *Table) BoolOr(path interface{}, def bool) bool {
+	return GetOr(t, path, def)
+}
+
+// Float64Or returns the value at path below t as a float64,
+// or def if it's not found or not a number.
+// The path is as of GetPath.
+func (t *Table) Float64Or(path interface{}, def float64) float64 {
+	return GetOr(t, path, def)
+}
+
+// DurationOr returns the value at path below t parsed as a time.Duration,
+// or def if it's not found or can't be parsed.
+// The path is as of GetPath.
+func (t *Table) DurationOr(path interface{}, def time.Duration) time.Duration {
+	return GetOr(t, path, def)
+}
+
+// TimeOr returns the value at path below t parsed as a time.Time in TimeLayout,
+// or def if it's not found or can't be parsed.
+// The path is as of GetPath.
+func (t *Table) TimeOr(path interface{}, def time.Time) time.Time {
+	return GetOr(t, path, def)
+}
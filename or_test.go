@@ -0,0 +1,69 @@
+package table
+
+import (
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Defaults", func() {
+	now := time.Now().Truncate(time.Second)
+	x := map[string]interface{}{
+		"port":    8080.0,
+		"ratio":   0.5,
+		"name":    "n",
+		"debug":   true,
+		"timeout": "3s",
+		"since":   now.Format(TimeLayout),
+		"nil":     nil,
+	}
+	t := New(x)
+
+	Specify("with found values", func() {
+		gomega.Expect(t.IntOr("port", 1)).Should(gomega.Equal(8080))
+		gomega.Expect(t.Float64Or("ratio", 1)).Should(gomega.Equal(0.5))
+		gomega.Expect(t.StringOr("name", "x")).Should(gomega.Equal("n"))
+		gomega.Expect(t.BoolOr("debug", false)).Should(gomega.BeTrue())
+		gomega.Expect(t.DurationOr("timeout", 0)).Should(gomega.Equal(3 * time.Second))
+		gomega.Expect(t.TimeOr("since", time.Time{}).Equal(now)).Should(gomega.BeTrue())
+	})
+	Specify("with missing or mismatched values", func() {
+		gomega.Expect(t.IntOr("ratio", 1)).Should(gomega.Equal(1))
+		gomega.Expect(t.IntOr("a.b", 2)).Should(gomega.Equal(2))
+		big := New(map[string]interface{}{"max": float64(1 << 63), "min": -float64(1 << 63), "below": 9.2e18})
+		gomega.Expect(big.IntOr("max", 3)).Should(gomega.Equal(3))
+		gomega.Expect(big.IntOr("min", 3)).Should(gomega.Equal(math.MinInt))
+		gomega.Expect(big.IntOr("below", 3)).Should(gomega.Equal(int(9.2e18)))
+		gomega.Expect(t.BoolOr("name", true)).Should(gomega.BeTrue())
+		gomega.Expect(t.DurationOr("name", time.Second)).Should(gomega.Equal(time.Second))
+		gomega.Expect(t.TimeOr("nil", now).Equal(now)).Should(gomega.BeTrue())
+	})
+	Specify("with Or()", func() {
+		gomega.Expect(Or(t, "port", 1.0)).Should(gomega.Equal(8080.0))
+		gomega.Expect(Or(t, "port", 1)).Should(gomega.Equal(1))
+		gomega.Expect(Or(t, "name", "x")).Should(gomega.Equal("n"))
+		gomega.Expect(Or(t, "ratio", 1.0)).Should(gomega.Equal(0.5))
+		gomega.Expect(Or(t, "name", 1)).Should(gomega.Equal(1))
+		gomega.Expect(Or(t, "a.b", "x")).Should(gomega.Equal("x"))
+		gomega.Expect(Or(t.At("a").At("b"), "c", "x")).Should(gomega.Equal("x"))
+	})
+	Specify("on the nil *Table", func() {
+		var nt *Table
+		gomega.Expect(nt.IntOr("a", 1)).Should(gomega.Equal(1))
+		gomega.Expect(nt.StringOr("a", "x")).Should(gomega.Equal("x"))
+		gomega.Expect(t.MustGet("missing").Float64Or("a", 2)).Should(gomega.Equal(2.0))
+		gomega.Expect(AsOr(nt, 3)).Should(gomega.Equal(3))
+		gomega.Expect(Or(nt, "a", 3)).Should(gomega.Equal(3))
+		gomega.Expect(AsOr(t.MustGet("name"), "x")).Should(gomega.Equal("n"))
+	})
+	Specify("with Has() and Exists()", func() {
+		gomega.Expect(t.Has("port")).Should(gomega.BeTrue())
+		gomega.Expect(t.Has("nil")).Should(gomega.BeTrue())
+		gomega.Expect(t.Has("missing")).Should(gomega.BeFalse())
+		gomega.Expect(t.Has("port.x")).Should(gomega.BeFalse())
+		gomega.Expect(t.MustGet("nil").Exists()).Should(gomega.BeTrue())
+		gomega.Expect(t.MustGet("missing").Exists()).Should(gomega.BeFalse())
+	})
+})
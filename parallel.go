@@ -0,0 +1,128 @@
+package table
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// EachCollectErrors makes EachDoParallel go on after an error,
+// and return all errors as an ErrMulti.
+func EachCollectErrors() EachOption {
+	return func(o *eachOptions) {
+		o.collectErrors = true
+	}
+}
+
+// EachDoParallel is like EachDoContext, but calls f in a pool of workers
+// goroutines, or of GOMAXPROCS goroutines if workers is less than 1.
+//
+// Keys and values are handed out in the order of EachDoContext, f may be
+// called concurrently. By default it stops at the first error and returns it,
+// EachCollectErrors makes it go on and return all errors in input order.
+func (t *Table) EachDoParallel(ctx context.Context, workers int, f eachDoFunc, opts ...EachOption) error {
+	return t.parallel(ctx, "Table.EachDoParallel", workers, newEachOptions(opts), func(_ int, k, v *Table) error {
+		return f(k, v)
+	})
+}
+
+// EachDoParallelOrdered is like EachDoParallel,
+// but returns what f returns for every item in input order.
+func (t *Table) EachDoParallelOrdered(ctx context.Context, workers int, f func(k, v *Table) (interface{}, error), opts ...EachOption) ([]interface{}, error) {
+	var mu sync.Mutex
+	results := map[int]interface{}{}
+	n := 0
+
+	err := t.parallel(ctx, "Table.EachDoParallelOrdered", workers, newEachOptions(opts), func(idx int, k, v *Table) error {
+		r, err := f(k, v)
+		mu.Lock()
+		results[idx] = r
+		if idx >= n {
+			n = idx + 1
+		}
+		mu.Unlock()
+		return err
+	})
+
+	rs := make([]interface{}, n)
+	for idx, r := range results {
+		rs[idx] = r
+	}
+	return rs, err
+}
+
+type parallelItem struct {
+	idx  int
+	k, v *Table
+}
+
+type parallelErr struct {
+	idx int
+	err error
+}
+
+// parallel calls f with every item of t and its index in workers goroutines.
+func (t *Table) parallel(ctx context.Context, method string, workers int, o *eachOptions, f func(idx int, k, v *Table) error) error {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu   sync.Mutex
+		errs []parallelErr
+		wg   sync.WaitGroup
+	)
+	fail := func(idx int, err error) {
+		mu.Lock()
+		errs = append(errs, parallelErr{idx, err})
+		mu.Unlock()
+		if !o.collectErrors {
+			cancel()
+		}
+	}
+
+	items := make(chan parallelItem)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for it := range items {
+				if err := f(it.idx, it.k, it.v); err != nil {
+					fail(it.idx, err)
+				}
+			}
+		}()
+	}
+
+	idx := 0
+	perr := t.eachContext(ctx, method, o, func(k, v *Table) error {
+		select {
+		case items <- parallelItem{idx, k, v}:
+			idx++
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	close(items)
+	wg.Wait()
+
+	switch {
+	case len(errs) == 0:
+		return perr
+	case !o.collectErrors, len(errs) == 1:
+		// errs are in the order they happened
+		return errs[0].err
+	}
+
+	sort.Slice(errs, func(i, j int) bool { return errs[i].idx < errs[j].idx })
+	es := make([]error, len(errs))
+	for i, e := range errs {
+		es[i] = e.err
+	}
+	return &ErrMulti{method, es}
+}
@@ -0,0 +1,89 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Parallel", func() {
+	xs := make([]int, 100)
+	for i := range xs {
+		xs[i] = i
+	}
+
+	Context("with EachDoParallel()", func() {
+		Specify("visits every item", func() {
+			var sum int64
+			err := New(xs).EachDoParallel(context.Background(), 4, func(k, v *Table) error {
+				atomic.AddInt64(&sum, v.MustInt64())
+				return nil
+			})
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(sum).Should(gomega.Equal(int64(99 * 100 / 2)))
+		})
+		Specify("stops on the first error", func() {
+			e := errors.New("e")
+			var n int64
+			err := New(xs).EachDoParallel(context.Background(), 2, func(k, v *Table) error {
+				atomic.AddInt64(&n, 1)
+				return e
+			})
+			gomega.Expect(err).Should(gomega.Equal(e))
+			gomega.Expect(atomic.LoadInt64(&n)).Should(gomega.BeNumerically("<", len(xs)))
+		})
+		Specify("returns the error happened first", func() {
+			err := New([]int{0, 1}).EachDoParallel(context.Background(), 2, func(k, v *Table) error {
+				if v.MustInt() == 0 {
+					time.Sleep(50 * time.Millisecond)
+				}
+				s, _ := k.String()
+				return errors.New(s)
+			})
+			gomega.Expect(err).Should(gomega.MatchError("1"))
+		})
+		Specify("collects all errors", func() {
+			err := New(xs).EachDoParallel(context.Background(), 3, func(k, v *Table) error {
+				if v.MustInt()%50 == 0 {
+					s, _ := k.String()
+					return errors.New(s)
+				}
+				return nil
+			}, EachCollectErrors())
+			gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrMulti)(nil)))
+			errs := err.(*ErrMulti).Errs
+			gomega.Expect(errs).Should(gomega.HaveLen(2))
+			gomega.Expect(errs[0].Error()).Should(gomega.Equal("0"))
+			gomega.Expect(errs[1].Error()).Should(gomega.Equal("50"))
+		})
+		Specify("in channel", func() {
+			c := make(chan int, len(xs))
+			for _, x := range xs {
+				c <- x
+			}
+			close(c)
+
+			var n int64
+			err := New(c).EachDoParallel(context.Background(), 0, func(k, v *Table) error {
+				atomic.AddInt64(&n, 1)
+				return nil
+			})
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(n).Should(gomega.Equal(int64(len(xs))))
+		})
+	})
+	Specify("with EachDoParallelOrdered()", func() {
+		rs, err := New(xs).EachDoParallelOrdered(context.Background(), 8, func(k, v *Table) (interface{}, error) {
+			return v.MustInt() * 2, nil
+		})
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(rs).Should(gomega.HaveLen(len(xs)))
+		for i, r := range rs {
+			gomega.Expect(r).Should(gomega.Equal(i * 2))
+		}
+	})
+})
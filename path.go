@@ -0,0 +1,180 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Path is the list of keys leading from a root Table to one of its nodes.
+//
+// A map key is kept as is, an array/slice index is an int,
+// and a struct field is its field name.
+type Path []interface{}
+
+// String returns p as dotted keys, e.g. "a.0.b".
+func (p Path) String() string {
+	ss := make([]string, len(p))
+	for i, k := range p {
+		ss[i] = fmt.Sprint(k)
+	}
+	return strings.Join(ss, ".")
+}
+
+// append returns a new path of p with k at the end, p is not changed.
+func (p Path) append(k interface{}) Path {
+	np := make(Path, len(p), len(p)+1)
+	copy(np, p)
+	return append(np, k)
+}
+
+// ParsePath parses the dotted keys s to a Path, e.g. "a.0.b".
+// The empty s is the empty path of the root.
+func ParsePath(s string) Path {
+	if s == "" {
+		return Path{}
+	}
+	ks := strings.Split(s, ".")
+	p := make(Path, len(ks))
+	for i, k := range ks {
+		p[i] = k
+	}
+	return p
+}
+
+// toPath returns path as a Path.
+// A string is parsed by ParsePath, a Path is itself, and others are a single key.
+func toPath(path interface{}) Path {
+	switch p := path.(type) {
+	case Path:
+		return p
+	case string:
+		return ParsePath(p)
+	default:
+		return Path{p}
+	}
+}
+
+// GetPath returns the value at path below t.
+//
+// The path is a Path, a string of dotted keys parsed by ParsePath, or a single key.
+// Every key is matched like Get does, but more loosely: a map key is converted
+// to the map's key type, e.g. "1" matches the key 1 of a map[int]T, an index
+// can be a string of int, and a struct field can also be named by its table tag.
+// It returns the nil if t is the nil, any key is not found or a nil is met on the path.
+// It returns error if a value on the path is not Map, Array, Slice or Struct,
+// or the error t carries, see At.
+func (t *Table) GetPath(path interface{}) (*Table, error) {
+	if t == nil {
+		return nil, nil
+	}
+	if t.err != nil {
+		return nil, t.err
+	}
+	p := toPath(path)
+	v := t.getv()
+	for _, k := range p {
+		v = indirect(v)
+		var ok bool
+		switch v.Kind() {
+		case reflect.Invalid:
+			return nil, nil
+		case reflect.Map:
+			v, ok = mapLookup(v, k)
+		case reflect.Array, reflect.Slice:
+			v, ok = sliceLookup(v, k)
+		case reflect.Struct:
+			v, ok = structLookup(v, k)
+		default:
+			return nil, &ErrUnsupportedKind{"Table.GetPath", v.Kind()}
+		}
+		if !ok {
+			return nil, nil
+		}
+	}
+	return &Table{v: v, path: append(t.path[:len(t.path):len(t.path)], p...), frozen: t.frozen}, nil
+}
+
+func mapLookup(m reflect.Value, k interface{}) (reflect.Value, bool) {
+	kv, ok := convKey(k, m.Type().Key())
+	if !ok {
+		return kv, false
+	}
+	v := m.MapIndex(kv)
+	return v, v.IsValid()
+}
+
+func sliceLookup(s reflect.Value, k interface{}) (reflect.Value, bool) {
+	idx, ok := sliceIndex(k)
+	if !ok || idx < 0 || idx >= s.Len() {
+		return s, false
+	}
+	return s.Index(idx), true
+}
+
+// sliceIndex returns the index k, an int or a string of int.
+func sliceIndex(k interface{}) (int, bool) {
+	switch x := k.(type) {
+	case int:
+		return x, true
+	case string:
+		i, err := strconv.Atoi(x)
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func structLookup(s reflect.Value, k interface{}) (reflect.Value, bool) {
+	name, ok := k.(string)
+	if !ok {
+		return s, false
+	}
+	if f := s.FieldByName(name); f.IsValid() {
+		return f, true
+	}
+	st := s.Type()
+	for i := 0; i < st.NumField(); i++ {
+		if st.Field(i).Tag.Get("table") == name {
+			return s.Field(i), true
+		}
+	}
+	return s, false
+}
+
+// convKey converts the key k to the type typ,
+// parsing k if it's a string and typ is not.
+func convKey(k interface{}, typ reflect.Type) (reflect.Value, bool) {
+	kv := reflect.ValueOf(k)
+	if !kv.IsValid() {
+		return assignable(kv, typ)
+	}
+	if kv.Type().AssignableTo(typ) {
+		return kv, true
+	}
+
+	s, isStr := k.(string)
+	switch {
+	case isStr && typ.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		return reflect.ValueOf(b).Convert(typ), err == nil
+	case isStr && isIntKind(typ.Kind()):
+		i, err := strconv.ParseInt(s, 10, typ.Bits())
+		return reflect.ValueOf(i).Convert(typ), err == nil
+	case isStr && isUintKind(typ.Kind()):
+		u, err := strconv.ParseUint(s, 10, typ.Bits())
+		return reflect.ValueOf(u).Convert(typ), err == nil
+	case isStr && (typ.Kind() == reflect.Float32 || typ.Kind() == reflect.Float64):
+		f, err := strconv.ParseFloat(s, typ.Bits())
+		return reflect.ValueOf(f).Convert(typ), err == nil
+	case orderRank(kv) == rankNumber && orderRank(reflect.Zero(typ)) == rankNumber:
+		cv := kv.Convert(typ)
+		// only if lossless
+		return cv, compareNumbers(cv, kv) == 0
+	case kv.Type().ConvertibleTo(typ) && kv.Kind() == typ.Kind():
+		return kv.Convert(typ), true
+	default:
+		return kv, false
+	}
+}
@@ -0,0 +1,157 @@
+package table
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// CompareFunc returns -1, 0 or +1 as a is less than, equal to or greater than b.
+type CompareFunc func(a, b *Table) int
+
+// compareTables is the default CompareFunc of sorting, see SortBy.
+func compareTables(a, b *Table) int {
+	return compareValues(a.getv(), b.getv())
+}
+
+// SortBy sorts t's underlying slice of records, e.g. maps, structs or pointers
+// to them, in place by the values at paths, as of GetPath.
+//
+// A path prefixed by "-" sorts descending, by "+" or nothing ascending,
+// later paths break ties of earlier ones, and sorting is stable.
+// Values are ordered as nil < bool < number < string < others, numbers of
+// any kinds are compared by value, and a missing value is the nil.
+// Without paths, the elements themselves are compared.
+// An array can be sorted in place only through a pointer to it.
+func (t *Table) SortBy(paths ...string) error {
+	return t.SortByFunc(compareTables, paths...)
+}
+
+// SortByFunc is like SortBy, but compares values by cmp.
+func (t *Table) SortByFunc(cmp CompareFunc, paths ...string) error {
+	if err := t.checkSet("Table.SortBy"); err != nil {
+		return err
+	}
+
+	sv := indirect(t.getv())
+	switch {
+	case sv.Kind() == reflect.Slice:
+	case sv.Kind() == reflect.Array && sv.CanAddr():
+		sv = sv.Slice(0, sv.Len())
+	case sv.Kind() == reflect.Array:
+		return &ErrCannotSet{"Table.SortBy"}
+	default:
+		return &ErrUnsupportedKind{"Table.SortBy", sv.Kind()}
+	}
+	defer t.touchIndexes()
+	return sortRecords(sv, cmp, paths)
+}
+
+// SortedBy is like SortBy, but sorts a copy of t's underlying array or slice,
+// and returns a Table of it.
+func (t *Table) SortedBy(paths ...string) (*Table, error) {
+	return t.SortedByFunc(compareTables, paths...)
+}
+
+// SortedByFunc is like SortedBy, but compares values by cmp.
+func (t *Table) SortedByFunc(cmp CompareFunc, paths ...string) (*Table, error) {
+	if err := t.check("Table.SortedBy"); err != nil {
+		return nil, err
+	}
+
+	sv := indirect(t.getv())
+	var nv, s reflect.Value
+	switch sv.Kind() {
+	case reflect.Slice:
+		nv = reflect.MakeSlice(sv.Type(), sv.Len(), sv.Len())
+		reflect.Copy(nv, sv)
+		s = nv
+	case reflect.Array:
+		nv = reflect.New(sv.Type()).Elem()
+		nv.Set(sv)
+		s = nv.Slice(0, nv.Len())
+	default:
+		return nil, &ErrUnsupportedKind{"Table.SortedBy", sv.Kind()}
+	}
+
+	if err := sortRecords(s, cmp, paths); err != nil {
+		return nil, err
+	}
+	return t.child(nv), nil
+}
+
+type recordSorter struct {
+	keys [][]*Table
+	desc []bool
+	cmp  CompareFunc
+	swap func(i, j int)
+}
+
+func (s *recordSorter) Len() int {
+	return len(s.keys)
+}
+
+func (s *recordSorter) Less(i, j int) bool {
+	for p, desc := range s.desc {
+		c := s.cmp(s.keys[i][p], s.keys[j][p])
+		if desc {
+			c = -c
+		}
+		if c != 0 {
+			return c < 0
+		}
+	}
+	return false
+}
+
+func (s *recordSorter) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.swap(i, j)
+}
+
+// sortRecords sorts the slice s by the values at paths.
+func sortRecords(s reflect.Value, cmp CompareFunc, paths []string) error {
+	if len(paths) == 0 {
+		paths = []string{""}
+	}
+
+	ps := make([]Path, len(paths))
+	desc := make([]bool, len(paths))
+	for i, p := range paths {
+		switch {
+		case strings.HasPrefix(p, "-"):
+			desc[i] = true
+			p = p[1:]
+		case strings.HasPrefix(p, "+"):
+			p = p[1:]
+		}
+		ps[i] = ParsePath(p)
+	}
+
+	keys := make([][]*Table, s.Len())
+	for i := range keys {
+		// a copy, elements of s are moved while sorting
+		ev := reflect.New(s.Type().Elem()).Elem()
+		ev.Set(s.Index(i))
+		e := &Table{v: ev}
+		keys[i] = make([]*Table, len(ps))
+		for j, p := range ps {
+			v, err := e.GetPath(p)
+			if err != nil {
+				return err
+			}
+			if v == nil {
+				v = New(nil)
+			}
+			keys[i][j] = v
+		}
+	}
+
+	sort.Stable(&recordSorter{
+		keys: keys,
+		desc: desc,
+		cmp:  cmp,
+		swap: reflect.Swapper(s.Interface()),
+	})
+	return nil
+}
@@ -0,0 +1,82 @@
+package table
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("SortBy", func() {
+	Specify("slice of maps in place", func() {
+		x := []map[string]interface{}{
+			{"name": "b", "age": 2.5},
+			{"name": "a", "age": 3},
+			{"name": "c", "age": uint(1)},
+			{"name": "d"},
+		}
+		gomega.Expect(New(x).SortBy("age")).Should(gomega.BeNil())
+		gomega.Expect([]interface{}{x[0]["name"], x[1]["name"], x[2]["name"], x[3]["name"]}).
+			Should(gomega.Equal([]interface{}{"d", "c", "b", "a"}))
+	})
+	Specify("descending and stable", func() {
+		type rec struct {
+			G int
+			N string
+		}
+		x := []rec{{1, "a"}, {2, "b"}, {1, "c"}, {2, "d"}}
+		gomega.Expect(New(x).SortBy("-G")).Should(gomega.BeNil())
+		gomega.Expect(x).Should(gomega.Equal([]rec{{2, "b"}, {2, "d"}, {1, "a"}, {1, "c"}}))
+	})
+	Specify("by several paths of pointers", func() {
+		type rec struct {
+			A struct{ B int }
+			N string
+		}
+		r1, r2, r3 := &rec{N: "x"}, &rec{N: "y"}, &rec{N: "z"}
+		r1.A.B, r2.A.B, r3.A.B = 1, 2, 1
+		x := []*rec{r1, r2, r3}
+		gomega.Expect(New(x).SortBy("+A.B", "-N")).Should(gomega.BeNil())
+		gomega.Expect(x).Should(gomega.Equal([]*rec{r3, r1, r2}))
+	})
+	Specify("elements themselves", func() {
+		x := []interface{}{"b", 2, nil, 1.5, true}
+		gomega.Expect(New(x).SortBy()).Should(gomega.BeNil())
+		gomega.Expect(x).Should(gomega.Equal([]interface{}{nil, true, 1.5, 2, "b"}))
+	})
+	Specify("with comparator", func() {
+		x := []string{"bb", "a", "ccc"}
+		byLen := func(a, b *Table) int {
+			as, _ := a.String()
+			bs, _ := b.String()
+			return len(bs) - len(as)
+		}
+		gomega.Expect(New(x).SortByFunc(byLen)).Should(gomega.BeNil())
+		gomega.Expect(x).Should(gomega.Equal([]string{"ccc", "bb", "a"}))
+	})
+	Specify("array through pointer", func() {
+		x := [3]int{3, 1, 2}
+		gomega.Expect(New(&x).SortBy()).Should(gomega.BeNil())
+		gomega.Expect(x).Should(gomega.Equal([3]int{1, 2, 3}))
+	})
+	Specify("array by value", func() {
+		gomega.Expect(New([3]int{3, 1, 2}).SortBy()).Should(gomega.Equal(&ErrCannotSet{"Table.SortBy"}))
+	})
+	Specify("not slice", func() {
+		gomega.Expect(New(map[string]int{}).SortBy()).Should(gomega.HaveOccurred())
+	})
+})
+
+var _ = Describe("SortedBy", func() {
+	Specify("of slice", func() {
+		x := []int{3, 1, 2}
+		s, err := New(x).SortedBy()
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(s.Interface()).Should(gomega.Equal([]int{1, 2, 3}))
+		gomega.Expect(x).Should(gomega.Equal([]int{3, 1, 2}))
+	})
+	Specify("of array", func() {
+		x := [3]int{3, 1, 2}
+		s, err := New(x).SortedBy("-")
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(s.Interface()).Should(gomega.Equal([3]int{3, 2, 1}))
+	})
+})
@@ -0,0 +1,601 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// SQL runs the SQL-like query on the records below t, and returns a Table of
+// the result rows as a []map[string]interface{}.
+//
+// The query is of the subset
+//
+//	SELECT items FROM path
+//	[WHERE expr] [GROUP BY exprs [HAVING expr]]
+//	[ORDER BY expr [ASC|DESC], ...] [LIMIT n] [OFFSET m]
+//
+// where path is "." for t itself or the path of an array or slice below t, and
+// items are "*" for all the fields of the records or expressions optionally
+// named by "AS name", every item is a column of the rows named by its text
+// otherwise. A column name in expressions is the path of a value below
+// a record, as of GetPath, and it's quoted by double quotes or backquotes as a single key.
+//
+// Expressions have 'strings', numbers, TRUE, FALSE, NULL, the operators
+// + - * / % || = != <> < <= > >= AND OR NOT, IS [NOT] NULL, [NOT] IN (...),
+// [NOT] LIKE, the functions lower, upper, length, abs and coalesce, and the
+// aggregates count, sum, avg, min and max. Values are compared as of SortBy,
+// and a comparison with NULL is NULL. Logic is three-valued, NULL is unknown,
+// e.g. NULL AND FALSE is FALSE, and NULL OR FALSE is NULL, which is false
+// only for WHERE and HAVING at last.
+//
+// Records are grouped as of GROUP BY, or into one group if any item is an
+// aggregate, a column not grouped by is of the first record of a group.
+// GROUP BY and ORDER BY can also refer to an item by its name or 1-based position.
+func (t *Table) SQL(query string) (*Table, error) {
+	if err := t.check("Table.SQL"); err != nil {
+		return nil, err
+	}
+	q, err := parseSQL(query)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := t.GetPath(q.from)
+	if err != nil {
+		return nil, err
+	}
+	if src == nil {
+		return nil, &ErrNotExist{"Table.SQL", "path " + q.from.String()}
+	}
+	_, recs, err := src.records("Table.SQL")
+	if err != nil {
+		return nil, err
+	}
+
+	var envs []*sqlEnv
+	for _, rec := range recs {
+		env := &sqlEnv{rec: rec}
+		if ok, err := env.test(q.where); err != nil {
+			return nil, err
+		} else if ok {
+			envs = append(envs, env)
+		}
+	}
+
+	if len(q.groupBy) > 0 || q.aggregated() {
+		if envs, err = q.group(envs); err != nil {
+			return nil, err
+		}
+		var having []*sqlEnv
+		for _, env := range envs {
+			if ok, err := env.test(q.having); err != nil {
+				return nil, err
+			} else if ok {
+				having = append(having, env)
+			}
+		}
+		envs = having
+	}
+
+	rows := make([]map[string]interface{}, len(envs))
+	for i, env := range envs {
+		if rows[i], err = q.project(env); err != nil {
+			return nil, err
+		}
+	}
+	if err := q.sort(envs, rows); err != nil {
+		return nil, err
+	}
+
+	if q.offset >= len(rows) {
+		rows = rows[:0]
+	} else {
+		rows = rows[q.offset:]
+	}
+	if q.limit >= 0 && q.limit < len(rows) {
+		rows = rows[:q.limit]
+	}
+	return t.child(reflect.ValueOf(rows)), nil
+}
+
+// aggregated reports whether any item of q is of an aggregate.
+func (q *sqlQuery) aggregated() bool {
+	for _, item := range q.items {
+		if !item.star && sqlHasAggregate(item.expr) {
+			return true
+		}
+	}
+	return sqlHasAggregate(q.having)
+}
+
+func sqlHasAggregate(x sqlExpr) bool {
+	switch x := x.(type) {
+	case *sqlCall:
+		if sqlAggregates[x.name] {
+			return true
+		}
+		for _, arg := range x.args {
+			if sqlHasAggregate(arg) {
+				return true
+			}
+		}
+	case *sqlUnary:
+		return sqlHasAggregate(x.x)
+	case *sqlBinary:
+		return sqlHasAggregate(x.l) || sqlHasAggregate(x.r)
+	case *sqlIsNull:
+		return sqlHasAggregate(x.x)
+	case *sqlIn:
+		for _, e := range x.list {
+			if sqlHasAggregate(e) {
+				return true
+			}
+		}
+		return sqlHasAggregate(x.x)
+	}
+	return false
+}
+
+// group groups the records of envs by GROUP BY of q in their first order,
+// all into one group if q has no GROUP BY.
+func (q *sqlQuery) group(envs []*sqlEnv) ([]*sqlEnv, error) {
+	if len(q.groupBy) == 0 {
+		g := &sqlEnv{grouped: true}
+		for _, env := range envs {
+			g.group = append(g.group, env.rec)
+		}
+		if len(g.group) > 0 {
+			g.rec = g.group[0]
+		}
+		return []*sqlEnv{g}, nil
+	}
+
+	keyType := reflect.ArrayOf(len(q.groupBy), _InterfaceType)
+	groups := map[interface{}]*sqlEnv{}
+	var gs []*sqlEnv
+	for _, env := range envs {
+		kv := reflect.New(keyType).Elem()
+		for i, x := range q.groupBy {
+			v, err := env.eval(q.item(x))
+			if err != nil {
+				return nil, err
+			}
+			k, err := hashKey("Table.SQL", reflect.ValueOf(v))
+			if err != nil {
+				return nil, err
+			}
+			if k != nil {
+				kv.Index(i).Set(reflect.ValueOf(k))
+			}
+		}
+
+		k := kv.Interface()
+		g, ok := groups[k]
+		if !ok {
+			g = &sqlEnv{rec: env.rec, grouped: true}
+			groups[k] = g
+			gs = append(gs, g)
+		}
+		g.group = append(g.group, env.rec)
+	}
+	return gs, nil
+}
+
+// project returns the row of the items of q of env.
+func (q *sqlQuery) project(env *sqlEnv) (map[string]interface{}, error) {
+	row := map[string]interface{}{}
+	for _, item := range q.items {
+		if item.star {
+			if env.rec == nil {
+				continue
+			}
+			fields, err := env.rec.fields()
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range fields {
+				row[k] = v
+			}
+			continue
+		}
+
+		v, err := env.eval(item.expr)
+		if err != nil {
+			return nil, err
+		}
+		row[item.name] = v
+	}
+	return row, nil
+}
+
+// sort sorts rows of envs by ORDER BY of q.
+func (q *sqlQuery) sort(envs []*sqlEnv, rows []map[string]interface{}) error {
+	if len(q.orderBy) == 0 {
+		return nil
+	}
+
+	keys := make([][]reflect.Value, len(rows))
+	for i, row := range rows {
+		keys[i] = make([]reflect.Value, len(q.orderBy))
+		for j, o := range q.orderBy {
+			v, err := q.orderValue(o.expr, envs[i], row)
+			if err != nil {
+				return err
+			}
+			keys[i][j] = reflect.ValueOf(v)
+		}
+	}
+
+	idx := make([]int, len(rows))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		ka, kb := keys[idx[a]], keys[idx[b]]
+		for j, o := range q.orderBy {
+			c := compareValues(ka[j], kb[j])
+			if o.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+
+	sorted := make([]map[string]interface{}, len(rows))
+	for i, j := range idx {
+		sorted[i] = rows[j]
+	}
+	copy(rows, sorted)
+	return nil
+}
+
+// item returns the expression of the item which x refers to by its name or
+// 1-based position, or x itself.
+func (q *sqlQuery) item(x sqlExpr) sqlExpr {
+	switch x := x.(type) {
+	case *sqlLit:
+		if n, ok := x.v.(int); ok && n >= 1 && n <= len(q.items) && !q.items[n-1].star {
+			return q.items[n-1].expr
+		}
+	case *sqlCol:
+		for _, item := range q.items {
+			if !item.star && item.name == x.name {
+				return item.expr
+			}
+		}
+	}
+	return x
+}
+
+// orderValue returns the value of the ORDER BY expression x of the row of env,
+// an int literal is the 1-based position of an item, and a column of the name
+// of an item is the item.
+func (q *sqlQuery) orderValue(x sqlExpr, env *sqlEnv, row map[string]interface{}) (interface{}, error) {
+	switch x := x.(type) {
+	case *sqlLit:
+		n, ok := x.v.(int)
+		if !ok {
+			return x.v, nil
+		}
+		if n < 1 || n > len(q.items) || q.items[n-1].star {
+			return nil, &ErrOutOfRange{"Table.SQL"}
+		}
+		return row[q.items[n-1].name], nil
+	case *sqlCol:
+		for _, item := range q.items {
+			if !item.star && item.name == x.name {
+				return row[item.name], nil
+			}
+		}
+	}
+	return env.eval(x)
+}
+
+// sqlEnv is a record, or a group of records of the first one, to evaluate
+// expressions of.
+type sqlEnv struct {
+	rec     *Table
+	group   []*Table
+	grouped bool
+}
+
+// test evaluates the condition x, true if x is nil.
+func (env *sqlEnv) test(x sqlExpr) (bool, error) {
+	if x == nil {
+		return true, nil
+	}
+	v, err := env.eval(x)
+	if err != nil {
+		return false, err
+	}
+	return sqlTruth(v)
+}
+
+// sqlTruth returns v as a condition, NULL is false,
+// it's only for WHERE and HAVING, as NULL is neither true nor false else.
+func sqlTruth(v interface{}) (bool, error) {
+	switch v := v.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	default:
+		return false, &ErrTypeUnequal{"Table.SQL", reflect.Bool, reflect.ValueOf(v).Kind()}
+	}
+}
+
+func (env *sqlEnv) eval(x sqlExpr) (interface{}, error) {
+	switch x := x.(type) {
+	case *sqlLit:
+		return x.v, nil
+
+	case *sqlCol:
+		if env.rec == nil {
+			return nil, nil
+		}
+		v, err := env.rec.GetPath(x.path)
+		if err != nil {
+			return nil, env.rec.mustErr(err)
+		}
+		if v == nil {
+			return nil, nil
+		}
+		iv := indirect(v.getv())
+		if !iv.IsValid() || !iv.CanInterface() {
+			return nil, nil
+		}
+		return iv.Interface(), nil
+
+	case *sqlUnary:
+		v, err := env.eval(x.x)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		if x.op == "NOT" {
+			b, err := sqlTruth(v)
+			return !b, err // v isn't NULL
+		}
+		return arith("Table.SQL", "-", 0, v)
+
+	case *sqlBinary:
+		return env.evalBinary(x)
+
+	case *sqlIsNull:
+		v, err := env.eval(x.x)
+		return (v == nil) != x.not, err
+
+	case *sqlIn:
+		v, err := env.eval(x.x)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		null := false
+		for _, e := range x.list {
+			ev, err := env.eval(e)
+			if err != nil {
+				return nil, err
+			}
+			if ev == nil {
+				null = true
+			} else if valueEqual(reflect.ValueOf(v), reflect.ValueOf(ev)) {
+				return !x.not, nil
+			}
+		}
+		if null { // may be equal to the NULL
+			return nil, nil
+		}
+		return x.not, nil
+
+	case *sqlCall:
+		if sqlAggregates[x.name] {
+			return env.aggregate(x)
+		}
+		return env.call(x)
+
+	default:
+		panic(fmt.Sprintf("table: unknown sql expression %T", x))
+	}
+}
+
+func (env *sqlEnv) evalBinary(x *sqlBinary) (interface{}, error) {
+	l, err := env.eval(x.l)
+	if err != nil {
+		return nil, err
+	}
+
+	if x.op == "AND" || x.op == "OR" {
+		return env.evalLogic(x, l)
+	}
+
+	r, err := env.eval(x.r)
+	if err != nil || l == nil || r == nil {
+		return nil, err
+	}
+	lv, rv := reflect.ValueOf(l), reflect.ValueOf(r)
+
+	switch x.op {
+	case "=":
+		return valueEqual(lv, rv), nil
+	case "!=":
+		return !valueEqual(lv, rv), nil
+	case "<":
+		return compareValues(lv, rv) < 0, nil
+	case "<=":
+		return compareValues(lv, rv) <= 0, nil
+	case ">":
+		return compareValues(lv, rv) > 0, nil
+	case ">=":
+		return compareValues(lv, rv) >= 0, nil
+	case "||":
+		return fmt.Sprint(l) + fmt.Sprint(r), nil
+	case "LIKE":
+		s, ok1 := l.(string)
+		pattern, ok2 := r.(string)
+		if !ok1 || !ok2 {
+			return nil, &ErrTypeUnequal{"Table.SQL", lv.Kind(), rv.Kind()}
+		}
+		if x.like == nil || x.likeOf != pattern {
+			x.like, x.likeOf = sqlLike(pattern), pattern
+		}
+		return x.like.MatchString(s), nil
+	default:
+		return arith("Table.SQL", x.op, l, r)
+	}
+}
+
+// evalLogic evaluates AND or OR of the left value l in the three-valued logic,
+// where NULL is unknown: false AND NULL is false, true OR NULL is true,
+// and others of NULL are NULL.
+func (env *sqlEnv) evalLogic(x *sqlBinary, l interface{}) (interface{}, error) {
+	short := x.op == "OR" // the value deciding the result alone
+	lb, err := sqlTruth(l)
+	if err != nil {
+		return nil, err
+	}
+	if l != nil && lb == short {
+		return lb, nil
+	}
+	r, err := env.eval(x.r)
+	if err != nil {
+		return nil, err
+	}
+	rb, err := sqlTruth(r)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case r != nil && rb == short:
+		return rb, nil
+	case l == nil || r == nil:
+		return nil, nil
+	default:
+		return !short, nil
+	}
+}
+
+// sqlLike returns the regexp of the LIKE pattern.
+func sqlLike(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?s)^")
+	for _, c := range pattern {
+		switch c {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+func (env *sqlEnv) call(x *sqlCall) (interface{}, error) {
+	args := make([]interface{}, len(x.args))
+	for i, arg := range x.args {
+		v, err := env.eval(arg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+
+	if x.name == "coalesce" {
+		for _, v := range args {
+			if v != nil {
+				return v, nil
+			}
+		}
+		return nil, nil
+	}
+
+	v := args[0]
+	if v == nil {
+		return nil, nil
+	}
+	switch x.name {
+	case "abs":
+		n, err := arith("Table.SQL", "-", 0, v)
+		if err != nil || compareValues(reflect.ValueOf(v), reflect.ValueOf(0)) >= 0 {
+			return v, err
+		}
+		return n, nil
+	case "length":
+		if s, ok := v.(string); ok {
+			return utf8.RuneCountInString(s), nil
+		}
+		if n := New(v).Len(); n >= 0 {
+			return n, nil
+		}
+		return nil, &ErrUnsupportedKind{"Table.SQL", reflect.ValueOf(v).Kind()}
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return nil, &ErrUnsupportedKind{"Table.SQL", reflect.ValueOf(v).Kind()}
+	}
+	if x.name == "lower" {
+		return strings.ToLower(s), nil
+	}
+	return strings.ToUpper(s), nil
+}
+
+// aggregate returns the aggregate x of the group of env, NULL values are
+// passed, and sum, avg, min and max of no value are NULL.
+func (env *sqlEnv) aggregate(x *sqlCall) (interface{}, error) {
+	if !env.grouped {
+		return nil, &ErrSyntax{"Table.SQL", x.pos, "aggregate " + x.name + " not allowed here"}
+	}
+	if x.star {
+		return len(env.group), nil
+	}
+
+	var vs []reflect.Value
+	for _, rec := range env.group {
+		v, err := (&sqlEnv{rec: rec}).eval(x.args[0])
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			vs = append(vs, reflect.ValueOf(v))
+		}
+	}
+
+	if x.name == "count" {
+		return len(vs), nil
+	}
+	if len(vs) == 0 {
+		return nil, nil
+	}
+
+	switch x.name {
+	case "min", "max":
+		r := vs[0]
+		for _, v := range vs[1:] {
+			if c := compareValues(v, r); (c < 0) == (x.name == "min") && c != 0 {
+				r = v
+			}
+		}
+		return r.Interface(), nil
+	default: // sum, avg
+		var sum interface{} = int64(0)
+		for _, v := range vs {
+			var err error
+			if sum, err = arith("Table.SQL", "+", sum, v.Interface()); err != nil {
+				return nil, err
+			}
+		}
+		if x.name == "avg" {
+			return arith("Table.SQL", "/", sum, len(vs))
+		}
+		return sum, nil
+	}
+}
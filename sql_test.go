@@ -0,0 +1,130 @@
+package table
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("SQL", func() {
+	type customer struct {
+		Name string
+		City string
+	}
+	type order struct {
+		ID       int
+		Customer customer
+		Status   string
+		Amount   float64
+	}
+	orders := []order{
+		{1, customer{"a", "x"}, "paid", 10},
+		{2, customer{"b", "y"}, "paid", 5},
+		{3, customer{"a", "x"}, "open", 7},
+		{4, customer{"c", "x"}, "paid", 1.5},
+		{5, customer{"a", "x"}, "paid", 2},
+	}
+	data := map[string]interface{}{"orders": orders}
+
+	query := func(t *Table, q string) []map[string]interface{} {
+		r, err := t.SQL(q)
+		gomega.ExpectWithOffset(1, err).Should(gomega.BeNil())
+		return r.Interface().([]map[string]interface{})
+	}
+
+	Specify("projection and where", func() {
+		rows := query(New(orders), "SELECT ID, Customer.Name AS name, Amount * 2 FROM . WHERE Status = 'paid' AND Amount > 2")
+		gomega.Expect(rows).Should(gomega.Equal([]map[string]interface{}{
+			{"ID": 1, "name": "a", "Amount * 2": 20.0},
+			{"ID": 2, "name": "b", "Amount * 2": 10.0},
+		}))
+	})
+	Specify("group by with aggregates", func() {
+		rows := query(New(data), "SELECT Customer.Name AS name, sum(Amount), count(*) AS n FROM orders WHERE status = 'paid' OR Status = 'paid' GROUP BY name ORDER BY 2 DESC LIMIT 2")
+		gomega.Expect(rows).Should(gomega.Equal([]map[string]interface{}{
+			{"name": "a", "sum(Amount)": 12.0, "n": 2},
+			{"name": "b", "sum(Amount)": 5.0, "n": 1},
+		}))
+	})
+	Specify("having and offset", func() {
+		rows := query(New(data), "select Customer.City, count(ID) as n from orders group by Customer.City having count(*) > 1 order by n")
+		gomega.Expect(rows).Should(gomega.Equal([]map[string]interface{}{{"Customer.City": "x", "n": 4}}))
+		rows = query(New(orders), "SELECT ID FROM . ORDER BY ID DESC LIMIT 2 OFFSET 1")
+		gomega.Expect(rows).Should(gomega.Equal([]map[string]interface{}{{"ID": 4}, {"ID": 3}}))
+	})
+	Specify("aggregates of all", func() {
+		rows := query(New(orders), "SELECT min(Amount), max(Customer.Name), avg(ID), sum(ID) FROM .")
+		gomega.Expect(rows).Should(gomega.Equal([]map[string]interface{}{
+			{"min(Amount)": 1.5, "max(Customer.Name)": "c", "avg(ID)": 3.0, "sum(ID)": int64(15)},
+		}))
+		rows = query(New(orders), "SELECT count(*), sum(ID) FROM . WHERE ID > 10")
+		gomega.Expect(rows).Should(gomega.Equal([]map[string]interface{}{{"count(*)": 0, "sum(ID)": nil}}))
+	})
+	Specify("star and operators", func() {
+		x := []map[string]interface{}{
+			{"k": "Foo", "v": nil},
+			{"k": "bar", "v": 2},
+			{"k": "baz", "v": uint(3)},
+		}
+		gomega.Expect(query(New(x), "SELECT * FROM . WHERE v IS NULL")).Should(gomega.Equal([]map[string]interface{}{x[0]}))
+		gomega.Expect(query(New(x), "SELECT upper(k) AS k FROM . WHERE k LIKE 'ba_' AND v NOT IN (2)")).
+			Should(gomega.Equal([]map[string]interface{}{{"k": "BAZ"}}))
+		gomega.Expect(query(New(x), "SELECT k || '!' AS s, coalesce(v, -1) AS v FROM . WHERE NOT k = 'bar' ORDER BY v")).
+			Should(gomega.Equal([]map[string]interface{}{{"s": "Foo!", "v": -1}, {"s": "baz!", "v": uint(3)}}))
+		gomega.Expect(query(New(x), "SELECT length(k) AS n, v % 2 AS m FROM . WHERE v <> 2")).
+			Should(gomega.Equal([]map[string]interface{}{{"n": 3, "m": int64(1)}}))
+	})
+	Specify("three-valued logic", func() {
+		x := []map[string]interface{}{{"k": "a", "v": nil}, {"k": "b", "v": 2}, {"k": "c", "v": 0}}
+		keys := func(where string) []interface{} {
+			var ks []interface{}
+			for _, r := range query(New(x), "SELECT k FROM . WHERE "+where) {
+				ks = append(ks, r["k"])
+			}
+			return ks
+		}
+		gomega.Expect(keys("NOT (v > 1 AND TRUE)")).Should(gomega.Equal([]interface{}{"c"}))
+		gomega.Expect(keys("NOT (v > 1 OR FALSE)")).Should(gomega.Equal([]interface{}{"c"}))
+		gomega.Expect(keys("v > 1 OR TRUE")).Should(gomega.Equal([]interface{}{"a", "b", "c"}))
+		gomega.Expect(keys("NOT (v > 1 AND FALSE)")).Should(gomega.Equal([]interface{}{"a", "b", "c"}))
+		gomega.Expect(keys("v IN (2, NULL)")).Should(gomega.Equal([]interface{}{"b"}))
+		gomega.Expect(keys("v NOT IN (2, NULL)")).Should(gomega.BeNil())
+		gomega.Expect(query(New(x), "SELECT v > 1 AND FALSE AS f, v > 1 AND TRUE AS n, v > 1 OR TRUE AS t FROM . WHERE k = 'a'")).
+			Should(gomega.Equal([]map[string]interface{}{{"f": false, "n": nil, "t": true}}))
+	})
+	Specify("non-ASCII names and LIKE patterns", func() {
+		x := []map[string]interface{}{{"名前": "太郎", "v": 1}, {"名前": "花子", "v": 2}}
+		gomega.Expect(query(New(x), "SELECT 名前 FROM . WHERE 名前 LIKE '_郎'\u00a0AND v = 1")).
+			Should(gomega.Equal([]map[string]interface{}{{"名前": "太郎"}}))
+		gomega.Expect(query(New(x), "SELECT v FROM . WHERE 名前 LIKE 名前")).Should(gomega.HaveLen(2))
+	})
+	Specify("quoted names", func() {
+		x := []map[string]interface{}{{"a.b": 1, "select": 2}}
+		gomega.Expect(query(New(x), "SELECT \"a.b\", `select` FROM .")).
+			Should(gomega.Equal([]map[string]interface{}{{"a.b": 1, "select": 2}}))
+	})
+
+	Context("errors", func() {
+		Specify("syntax", func() {
+			ExpectErr(New(orders).SQL("SELECT ID FROM . WHERE")).
+				Should(gomega.Equal(&ErrSyntax{"Table.SQL", 22, "expected expression, got end of query"}))
+			ExpectErr(New(orders).SQL("SELECT ID FORM .")).
+				Should(gomega.Equal(&ErrSyntax{"Table.SQL", 10, "expected FROM, got \"FORM\""}))
+			ExpectErr(New(orders).SQL("SELECT foo(ID) FROM .")).
+				Should(gomega.Equal(&ErrSyntax{"Table.SQL", 7, "unknown function foo"}))
+			ExpectErr(New(orders).SQL("SELECT 'a FROM .")).
+				Should(gomega.Equal(&ErrSyntax{"Table.SQL", 7, "unterminated '"}))
+		})
+		Specify("aggregate in where", func() {
+			ExpectErr(New(orders).SQL("SELECT ID FROM . WHERE sum(ID) > 1")).
+				Should(gomega.Equal(&ErrSyntax{"Table.SQL", 23, "aggregate sum not allowed here"}))
+		})
+		Specify("missing from", func() {
+			ExpectErr(New(data).SQL("SELECT * FROM users")).
+				Should(gomega.Equal(&ErrNotExist{"Table.SQL", "path users"}))
+		})
+		Specify("not condition", func() {
+			_, err := New(orders).SQL("SELECT ID FROM . WHERE Amount")
+			gomega.Expect(err).Should(gomega.HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,638 @@
+package table
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type sqlTokenKind int
+
+const (
+	sqlEOF sqlTokenKind = iota
+	sqlIdent
+	sqlQuotedIdent
+	sqlKeyword
+	sqlNumber
+	sqlString
+	sqlOp
+)
+
+type sqlToken struct {
+	kind     sqlTokenKind
+	text     string
+	pos, end int
+}
+
+var sqlKeywords = map[string]bool{
+	"SELECT": true, "FROM": true, "WHERE": true, "GROUP": true, "BY": true,
+	"HAVING": true, "ORDER": true, "ASC": true, "DESC": true, "LIMIT": true,
+	"OFFSET": true, "AS": true, "AND": true, "OR": true, "NOT": true,
+	"IN": true, "IS": true, "NULL": true, "LIKE": true, "TRUE": true, "FALSE": true,
+}
+
+// sqlOps are the operators, longer ones first.
+var sqlOps = []string{
+	"<>", "!=", "<=", ">=", "||",
+	"(", ")", ",", ".", "*", "+", "-", "/", "%", "=", "<", ">",
+}
+
+// scanRunes returns the end of the runes of s from i for which f is true.
+func scanRunes(s string, i int, f func(rune) bool) int {
+	for i < len(s) {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if !f(r) {
+			break
+		}
+		i += n
+	}
+	return i
+}
+
+func isSQLIdentRune(r rune) bool {
+	return r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func lexSQL(q string) ([]sqlToken, error) {
+	var toks []sqlToken
+	i := 0
+	for {
+		i = scanRunes(q, i, unicode.IsSpace)
+		if i >= len(q) {
+			return append(toks, sqlToken{kind: sqlEOF, pos: i, end: i}), nil
+		}
+
+		start := i
+		c := q[i]
+		r, _ := utf8.DecodeRuneInString(q[i:])
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+			i = scanRunes(q, i, isSQLIdentRune)
+			text := q[start:i]
+			if up := strings.ToUpper(text); sqlKeywords[up] {
+				toks = append(toks, sqlToken{sqlKeyword, up, start, i})
+			} else {
+				toks = append(toks, sqlToken{sqlIdent, text, start, i})
+			}
+
+		case c >= '0' && c <= '9':
+			for i < len(q) && (unicode.IsDigit(rune(q[i])) || q[i] == '.') {
+				i++
+			}
+			if i < len(q) && (q[i] == 'e' || q[i] == 'E') {
+				i++
+				if i < len(q) && (q[i] == '+' || q[i] == '-') {
+					i++
+				}
+				for i < len(q) && unicode.IsDigit(rune(q[i])) {
+					i++
+				}
+			}
+			toks = append(toks, sqlToken{sqlNumber, q[start:i], start, i})
+
+		case c == '\'' || c == '"' || c == '`':
+			var b strings.Builder
+			i++
+			for {
+				if i >= len(q) {
+					return nil, &ErrSyntax{"Table.SQL", start, "unterminated " + string(c)}
+				}
+				if q[i] == c {
+					if i+1 < len(q) && q[i+1] == c { // escaped
+						b.WriteByte(c)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				b.WriteByte(q[i])
+				i++
+			}
+			kind := sqlString
+			if c != '\'' {
+				kind = sqlQuotedIdent
+			}
+			toks = append(toks, sqlToken{kind, b.String(), start, i})
+
+		default:
+			op := ""
+			for _, o := range sqlOps {
+				if strings.HasPrefix(q[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, &ErrSyntax{"Table.SQL", start, "unexpected " + strconv.QuoteRune(r)}
+			}
+			i += len(op)
+			toks = append(toks, sqlToken{sqlOp, op, start, i})
+		}
+	}
+}
+
+// sql expressions
+type (
+	sqlExpr interface{}
+
+	sqlLit struct {
+		v interface{}
+	}
+
+	sqlCol struct {
+		name string
+		path Path
+	}
+
+	// sqlUnary is "-" or "NOT" of x.
+	sqlUnary struct {
+		op string
+		x  sqlExpr
+	}
+
+	// sqlBinary is an arithmetic, comparison, logical, "||" or "LIKE" operation.
+	sqlBinary struct {
+		op   string
+		l, r sqlExpr
+
+		// like is the regexp of the last pattern of LIKE, likeOf.
+		like   *regexp.Regexp
+		likeOf string
+	}
+
+	sqlIn struct {
+		x    sqlExpr
+		list []sqlExpr
+		not  bool
+	}
+
+	sqlIsNull struct {
+		x   sqlExpr
+		not bool
+	}
+
+	// sqlCall is a call of a function, star is of count(*).
+	sqlCall struct {
+		name string
+		args []sqlExpr
+		star bool
+		pos  int
+	}
+)
+
+var (
+	sqlAggregates = map[string]bool{"count": true, "sum": true, "avg": true, "min": true, "max": true}
+	sqlFunctions  = map[string]bool{"lower": true, "upper": true, "length": true, "abs": true, "coalesce": true}
+)
+
+type sqlItem struct {
+	expr sqlExpr
+	name string
+	star bool
+}
+
+type sqlOrder struct {
+	expr sqlExpr
+	desc bool
+}
+
+type sqlQuery struct {
+	items   []sqlItem
+	from    Path
+	where   sqlExpr
+	groupBy []sqlExpr
+	having  sqlExpr
+	orderBy []sqlOrder
+	limit   int // negative for none
+	offset  int
+}
+
+type sqlParser struct {
+	q    string
+	toks []sqlToken
+	i    int
+}
+
+func parseSQL(q string) (*sqlQuery, error) {
+	toks, err := lexSQL(q)
+	if err != nil {
+		return nil, err
+	}
+	p := &sqlParser{q: q, toks: toks}
+	return p.parseQuery()
+}
+
+func (p *sqlParser) peek() sqlToken {
+	return p.toks[p.i]
+}
+
+func (p *sqlParser) next() sqlToken {
+	tok := p.toks[p.i]
+	if tok.kind != sqlEOF {
+		p.i++
+	}
+	return tok
+}
+
+// prevEnd returns the end of the last token read.
+func (p *sqlParser) prevEnd() int {
+	if p.i == 0 {
+		return 0
+	}
+	return p.toks[p.i-1].end
+}
+
+func (p *sqlParser) errorf(tok sqlToken, msg string) error {
+	if tok.kind == sqlEOF {
+		return &ErrSyntax{"Table.SQL", tok.pos, msg + ", got end of query"}
+	}
+	return &ErrSyntax{"Table.SQL", tok.pos, msg + ", got " + strconv.Quote(tok.text)}
+}
+
+func (p *sqlParser) isKeyword(kw string) bool {
+	tok := p.peek()
+	return tok.kind == sqlKeyword && tok.text == kw
+}
+
+func (p *sqlParser) acceptKeyword(kw string) bool {
+	if p.isKeyword(kw) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *sqlParser) expectKeyword(kw string) error {
+	if !p.acceptKeyword(kw) {
+		return p.errorf(p.peek(), "expected "+kw)
+	}
+	return nil
+}
+
+func (p *sqlParser) isOp(op string) bool {
+	tok := p.peek()
+	return tok.kind == sqlOp && tok.text == op
+}
+
+func (p *sqlParser) acceptOp(op string) bool {
+	if p.isOp(op) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *sqlParser) expectOp(op string) error {
+	if !p.acceptOp(op) {
+		return p.errorf(p.peek(), "expected "+strconv.Quote(op))
+	}
+	return nil
+}
+
+func (p *sqlParser) parseQuery() (*sqlQuery, error) {
+	q := &sqlQuery{limit: -1}
+	var err error
+
+	if err = p.expectKeyword("SELECT"); err != nil {
+		return nil, err
+	}
+	if q.items, err = p.parseItems(); err != nil {
+		return nil, err
+	}
+
+	if err = p.expectKeyword("FROM"); err != nil {
+		return nil, err
+	}
+	switch tok := p.next(); {
+	case tok.kind == sqlOp && tok.text == ".":
+		q.from = Path{}
+	case tok.kind == sqlIdent:
+		q.from = ParsePath(tok.text)
+	case tok.kind == sqlQuotedIdent:
+		q.from = Path{tok.text}
+	default:
+		return nil, p.errorf(tok, "expected path")
+	}
+
+	if p.acceptKeyword("WHERE") {
+		if q.where, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.acceptKeyword("GROUP") {
+		if err = p.expectKeyword("BY"); err != nil {
+			return nil, err
+		}
+		for {
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			q.groupBy = append(q.groupBy, x)
+			if !p.acceptOp(",") {
+				break
+			}
+		}
+	}
+	if p.acceptKeyword("HAVING") {
+		if q.having, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.acceptKeyword("ORDER") {
+		if err = p.expectKeyword("BY"); err != nil {
+			return nil, err
+		}
+		for {
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			o := sqlOrder{expr: x}
+			if p.acceptKeyword("DESC") {
+				o.desc = true
+			} else {
+				p.acceptKeyword("ASC")
+			}
+			q.orderBy = append(q.orderBy, o)
+			if !p.acceptOp(",") {
+				break
+			}
+		}
+	}
+	if p.acceptKeyword("LIMIT") {
+		if q.limit, err = p.parseCount(); err != nil {
+			return nil, err
+		}
+	}
+	if p.acceptKeyword("OFFSET") {
+		if q.offset, err = p.parseCount(); err != nil {
+			return nil, err
+		}
+	}
+
+	if tok := p.peek(); tok.kind != sqlEOF {
+		return nil, p.errorf(tok, "expected end of query")
+	}
+	return q, nil
+}
+
+func (p *sqlParser) parseItems() ([]sqlItem, error) {
+	var items []sqlItem
+	for {
+		if p.acceptOp("*") {
+			items = append(items, sqlItem{star: true})
+		} else {
+			start := p.peek().pos
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			item := sqlItem{expr: x, name: p.q[start:p.prevEnd()]}
+			if c, ok := x.(*sqlCol); ok {
+				item.name = c.name
+			}
+			if p.acceptKeyword("AS") {
+				tok := p.next()
+				if tok.kind != sqlIdent && tok.kind != sqlQuotedIdent && tok.kind != sqlString {
+					return nil, p.errorf(tok, "expected name")
+				}
+				item.name = tok.text
+			}
+			items = append(items, item)
+		}
+		if !p.acceptOp(",") {
+			return items, nil
+		}
+	}
+}
+
+// parseCount parses a non-negative int of LIMIT or OFFSET.
+func (p *sqlParser) parseCount() (int, error) {
+	tok := p.next()
+	n, err := strconv.Atoi(tok.text)
+	if tok.kind != sqlNumber || err != nil || n < 0 {
+		return 0, p.errorf(tok, "expected count")
+	}
+	return n, nil
+}
+
+func (p *sqlParser) parseExpr() (sqlExpr, error) {
+	return p.parseOr()
+}
+
+func (p *sqlParser) parseOr() (sqlExpr, error) {
+	l, err := p.parseAnd()
+	for err == nil && p.acceptKeyword("OR") {
+		var r sqlExpr
+		if r, err = p.parseAnd(); err == nil {
+			l = &sqlBinary{op: "OR", l: l, r: r}
+		}
+	}
+	return l, err
+}
+
+func (p *sqlParser) parseAnd() (sqlExpr, error) {
+	l, err := p.parseNot()
+	for err == nil && p.acceptKeyword("AND") {
+		var r sqlExpr
+		if r, err = p.parseNot(); err == nil {
+			l = &sqlBinary{op: "AND", l: l, r: r}
+		}
+	}
+	return l, err
+}
+
+func (p *sqlParser) parseNot() (sqlExpr, error) {
+	if p.acceptKeyword("NOT") {
+		x, err := p.parseNot()
+		return &sqlUnary{"NOT", x}, err
+	}
+	return p.parseCmp()
+}
+
+func (p *sqlParser) parseCmp() (sqlExpr, error) {
+	l, err := p.parseAdd()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range []string{"=", "!=", "<>", "<=", ">=", "<", ">"} {
+		if p.acceptOp(op) {
+			if op == "<>" {
+				op = "!="
+			}
+			r, err := p.parseAdd()
+			return &sqlBinary{op: op, l: l, r: r}, err
+		}
+	}
+
+	if p.acceptKeyword("IS") {
+		not := p.acceptKeyword("NOT")
+		if err := p.expectKeyword("NULL"); err != nil {
+			return nil, err
+		}
+		return &sqlIsNull{l, not}, nil
+	}
+
+	not := p.acceptKeyword("NOT")
+	switch {
+	case p.acceptKeyword("IN"):
+		if err := p.expectOp("("); err != nil {
+			return nil, err
+		}
+		in := &sqlIn{x: l, not: not}
+		for {
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			in.list = append(in.list, x)
+			if !p.acceptOp(",") {
+				break
+			}
+		}
+		return in, p.expectOp(")")
+	case p.acceptKeyword("LIKE"):
+		r, err := p.parseAdd()
+		var x sqlExpr = &sqlBinary{op: "LIKE", l: l, r: r}
+		if not {
+			x = &sqlUnary{"NOT", x}
+		}
+		return x, err
+	case not:
+		return nil, p.errorf(p.peek(), "expected IN or LIKE")
+	}
+	return l, nil
+}
+
+func (p *sqlParser) parseAdd() (sqlExpr, error) {
+	l, err := p.parseMul()
+	for err == nil && (p.isOp("+") || p.isOp("-") || p.isOp("||")) {
+		op := p.next().text
+		var r sqlExpr
+		if r, err = p.parseMul(); err == nil {
+			l = &sqlBinary{op: op, l: l, r: r}
+		}
+	}
+	return l, err
+}
+
+func (p *sqlParser) parseMul() (sqlExpr, error) {
+	l, err := p.parseUnary()
+	for err == nil && (p.isOp("*") || p.isOp("/") || p.isOp("%")) {
+		op := p.next().text
+		var r sqlExpr
+		if r, err = p.parseUnary(); err == nil {
+			l = &sqlBinary{op: op, l: l, r: r}
+		}
+	}
+	return l, err
+}
+
+func (p *sqlParser) parseUnary() (sqlExpr, error) {
+	switch {
+	case p.acceptOp("-"):
+		x, err := p.parseUnary()
+		if lit, ok := x.(*sqlLit); ok { // negative literals
+			switch v := lit.v.(type) {
+			case int:
+				return &sqlLit{-v}, nil
+			case float64:
+				return &sqlLit{-v}, nil
+			}
+		}
+		return &sqlUnary{"-", x}, err
+	case p.acceptOp("+"):
+		return p.parseUnary()
+	default:
+		return p.parsePrimary()
+	}
+}
+
+func (p *sqlParser) parsePrimary() (sqlExpr, error) {
+	tok := p.next()
+	switch tok.kind {
+	case sqlNumber:
+		if i, err := strconv.Atoi(tok.text); err == nil {
+			return &sqlLit{i}, nil
+		}
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf(tok, "expected number")
+		}
+		return &sqlLit{f}, nil
+
+	case sqlString:
+		return &sqlLit{tok.text}, nil
+
+	case sqlKeyword:
+		switch tok.text {
+		case "NULL":
+			return &sqlLit{nil}, nil
+		case "TRUE":
+			return &sqlLit{true}, nil
+		case "FALSE":
+			return &sqlLit{false}, nil
+		}
+
+	case sqlQuotedIdent:
+		return &sqlCol{tok.text, Path{tok.text}}, nil
+
+	case sqlIdent:
+		if p.isOp("(") {
+			return p.parseCall(tok)
+		}
+		return &sqlCol{tok.text, ParsePath(tok.text)}, nil
+
+	case sqlOp:
+		if tok.text == "(" {
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expectOp(")")
+		}
+	}
+	return nil, p.errorf(tok, "expected expression")
+}
+
+func (p *sqlParser) parseCall(name sqlToken) (sqlExpr, error) {
+	call := &sqlCall{name: strings.ToLower(name.text), pos: name.pos}
+	if !sqlAggregates[call.name] && !sqlFunctions[call.name] {
+		return nil, &ErrSyntax{"Table.SQL", name.pos, "unknown function " + name.text}
+	}
+	p.next() // (
+
+	switch {
+	case call.name == "count" && p.acceptOp("*"):
+		call.star = true
+	case p.isOp(")"):
+	default:
+		for {
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, x)
+			if !p.acceptOp(",") {
+				break
+			}
+		}
+	}
+	if err := p.expectOp(")"); err != nil {
+		return nil, err
+	}
+
+	n := len(call.args)
+	if call.star {
+		n = 1
+	}
+	if (call.name == "coalesce" && n == 0) || (call.name != "coalesce" && n != 1) {
+		return nil, &ErrSyntax{"Table.SQL", name.pos, "wrong number of arguments to " + name.text}
+	}
+	return call, nil
+}
@@ -2,7 +2,10 @@
 // convenient and simple, and reflect-based.
 package table
 
+//go:generate go run gen_must.go
+
 import (
+	"bytes"
 	"fmt"
 	"math/bits"
 	"reflect"
@@ -12,6 +15,16 @@ import (
 type Table struct {
 	i interface{}
 	v reflect.Value
+
+	// path is the path t is got at, err is the error got it, see At.
+	path Path
+	err  error
+
+	// indexes are built on t by Index, see Put, Insert and Delete.
+	indexes []*Index
+
+	// frozen is set by Freeze, see checkSet.
+	frozen bool
 }
 
 // New new a Table from v
@@ -28,25 +41,36 @@ func New(v interface{}) *Table {
 // It returns the nil if k is not found in the t.
 // It returns error if t's kind is not Map, Array, Slice or Struct.
 func (t *Table) Get(k interface{}) (*Table, error) {
+	if err := t.check("Table.Get"); err != nil {
+		return nil, err
+	}
+
+	var r *Table
 	v := t.getv()
 	switch v.Kind() {
 	case reflect.Map:
-		return t.mapGet(k), nil
+		r = t.mapGet(k)
 	case reflect.Array, reflect.Slice:
-		return t.sliceGet(k.(int)), nil
+		r = t.sliceGet(k.(int))
 	case reflect.Struct:
-		return t.structGet(k.(string)), nil
+		r = t.structGet(k.(string))
 	case reflect.Interface, reflect.Ptr:
-		vt := &Table{v: indirect(v)}
+		vt := t.child(indirect(v))
+		vt.path = t.path
 		return vt.Get(k)
 	default:
 		return nil, &ErrUnsupportedKind{"Table.Get", v.Kind()}
 	}
+	if r != nil {
+		r.path = t.path.append(k)
+		r.frozen = t.frozen
+	}
+	return r, nil
 }
 
 // Set set t's value to v.
 //
-// If t's value can't setable, returns ErrCannotSet.
+// If t's value can't setable or t is frozen, returns ErrCannotSet.
 // If t's kind and v's kind is not equivalence, returns ErrTypeUnequal.
 // It returns nil, that set successful.
 //
@@ -54,6 +78,10 @@ func (t *Table) Get(k interface{}) (*Table, error) {
 //
 //  TODO: balala
 func (t *Table) Set(v interface{}) error {
+	if err := t.checkSet("Table.Set"); err != nil {
+		return err
+	}
+
 	tv := t.getv()
 	if tv.Kind() == reflect.Interface || tv.Kind() == reflect.Ptr {
 		tv = tv.Elem()
@@ -72,6 +100,7 @@ func (t *Table) Set(v interface{}) error {
 
 	// reset
 	t.i = nil
+	t.touchIndexes()
 
 	return nil
 }
@@ -85,7 +114,19 @@ func (t *Table) Set(v interface{}) error {
 // If k in t, and set k's value to v.
 //
 // If t's kind is not map, array, slice or struct, returns ErrUnsupportedKind.
+// If v violates a unique Index of t, returns ErrConflict.
+// If t is frozen, returns ErrCannotSet.
 func (t *Table) Put(k, v interface{}) (err error) {
+	if err := t.checkSet("Table.Put"); err != nil {
+		return err
+	}
+	if idx, ok := k.(int); ok {
+		if err := t.checkIndexes("Table.Put", idx, v); err != nil {
+			return err
+		}
+	}
+	defer t.touchIndexes()
+
 	tv := t.getv()
 
 	switch tv.Kind() {
@@ -108,18 +149,25 @@ func (t *Table) Put(k, v interface{}) (err error) {
 	}
 }
 
-// Bytes returns t's underlying value as a []bytes.
+// Bytes returns t's underlying value as a []bytes, a copy of it if t is frozen.
 // It returns error if t's underlying value is not a slice of bytes.
 func (t *Table) Bytes() ([]byte, error) {
+	if err := t.check("Table.Bytes"); err != nil {
+		return nil, err
+	}
+
 	tv := t.getv()
 	switch tv.Kind() {
 	case reflect.Interface, reflect.Ptr:
-		return (&Table{v: indirect(tv)}).Bytes()
+		return t.child(indirect(tv)).Bytes()
 	case reflect.Slice:
 		elemk := tv.Type().Elem().Kind()
 		if elemk != reflect.Uint8 {
 			return nil, &ErrUnsupportedKind{"Table.Bytes", "slice of " + elemk.String()}
 		}
+		if t.frozen {
+			return bytes.Clone(tv.Bytes()), nil
+		}
 		return tv.Bytes(), nil
 	default:
 		return nil, &ErrUnsupportedKind{"Table.Bytes", t.getv().Kind()}
@@ -129,6 +177,10 @@ func (t *Table) Bytes() ([]byte, error) {
 // Bool returns t's underlying value.
 // It returns error if t's kind is not Bool.
 func (t *Table) Bool() (bool, error) {
+	if err := t.check("Table.Bool"); err != nil {
+		return false, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Bool:
 		return t.bool(), nil
@@ -143,6 +195,10 @@ func (t *Table) Bool() (bool, error) {
 // It returns error if t's kind is not Int, Int8, Int16, Int32, Uint8 or Uint16,
 // and if t's kind is Int64 or Uint32 also Int is 32 bits.
 func (t *Table) Int() (i int, err error) {
+	if err := t.check("Table.Int"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
 		i = int(t.int())
@@ -175,6 +231,10 @@ func (t *Table) Int() (i int, err error) {
 // Int8 returns t's underlying value as an int8.
 // It returns error if t's kind is not Int8.
 func (t *Table) Int8() (int8, error) {
+	if err := t.check("Table.Int8"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Int8:
 		return int8(t.int()), nil
@@ -188,6 +248,10 @@ func (t *Table) Int8() (int8, error) {
 // Int16 returns t's underlying value as an int16.
 // It returns error if t's kind is not Int, Int8, Int16, or Uint8.
 func (t *Table) Int16() (int16, error) {
+	if err := t.check("Table.Int16"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Int8, reflect.Int16:
 		return int16(t.int()), nil
@@ -204,6 +268,10 @@ func (t *Table) Int16() (int16, error) {
 // It returns error if t's kind is not Int, Int8, Int16, Int32, Uint8 or Uint16,
 // and if t's kind is Int also Int is 64 bits.
 func (t *Table) Int32() (int32, error) {
+	if err := t.check("Table.Int32"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Int8, reflect.Int16, reflect.Int32:
 		return int32(t.int()), nil
@@ -228,6 +296,10 @@ func (t *Table) Int32() (int32, error) {
 // It returns error if t's kind is not Int, Int8, Int16, Int32, Uint8, Uint16, Uint32
 // and if t's kind is Uint also Uint is 64 bits.
 func (t *Table) Int64() (int64, error) {
+	if err := t.check("Table.Int64"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return t.int(), nil
@@ -252,6 +324,10 @@ func (t *Table) Int64() (int64, error) {
 // It returns error if t's kind is not Uint, Uint8, Uint16 or Uint32,
 // and if t's kind is Uint64 also Uint is 32 bits.
 func (t *Table) Uint() (i uint, err error) {
+	if err := t.check("Table.Uint"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
 		i = uint(t.uint())
@@ -273,6 +349,10 @@ func (t *Table) Uint() (i uint, err error) {
 // Uint8 returns t's underlying value as an uint8.
 // It returns error if t's kind is not Uint8.
 func (t *Table) Uint8() (uint8, error) {
+	if err := t.check("Table.Uint8"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Interface, reflect.Ptr:
 		return (&Table{v: indirect(t.getv())}).Uint8()
@@ -286,6 +366,10 @@ func (t *Table) Uint8() (uint8, error) {
 // Uint16 returns t's underlying value as an uint16.
 // It returns error if t's kind is not Uint8 or Uint16.
 func (t *Table) Uint16() (uint16, error) {
+	if err := t.check("Table.Uint16"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Uint8, reflect.Uint16:
 		return uint16(t.uint()), nil
@@ -300,6 +384,10 @@ func (t *Table) Uint16() (uint16, error) {
 // It returns error if t's kind is not Uint8, Uint16 or Uint32,
 // and if t's kind is Uint also Uint is 64 bits.
 func (t *Table) Uint32() (uint32, error) {
+	if err := t.check("Table.Uint32"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
 		return uint32(t.uint()), nil
@@ -321,6 +409,10 @@ func (t *Table) Uint32() (uint32, error) {
 // Uint64 returns t's underlying value as an uint64.
 // It returns error if t's kind is not Uint*.
 func (t *Table) Uint64() (uint64, error) {
+	if err := t.check("Table.Uint64"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		return t.uint(), nil
@@ -334,6 +426,10 @@ func (t *Table) Uint64() (uint64, error) {
 // Float32 returns t's underlying value as an float32.
 // It returns error if t's kind is not Uint*, Int* or Float32.
 func (t *Table) Float32() (float32, error) {
+	if err := t.check("Table.Float32"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return float32(t.int()), nil
@@ -351,6 +447,10 @@ func (t *Table) Float32() (float32, error) {
 // Float64 returns t's underlying value as an float64.
 // It returns error if t's kind is not Uint*, Int* or Float*.
 func (t *Table) Float64() (float64, error) {
+	if err := t.check("Table.Float64"); err != nil {
+		return 0, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return float64(t.int()), nil
@@ -368,6 +468,10 @@ func (t *Table) Float64() (float64, error) {
 // Complex64 returns t's underlying value as an complex64.
 // It returns error if t's kind is not Uint*, Int*, Float32 or Complex64.
 func (t *Table) Complex64() (complex64, error) {
+	if err := t.check("Table.Complex64"); err != nil {
+		return 0i, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		return complex(float32(t.uint()), 0), nil
@@ -387,6 +491,10 @@ func (t *Table) Complex64() (complex64, error) {
 // Complex128 returns t's underlying value as an complex128.
 // It returns error if t's kind is not Uint*, Int*, Float* or Complex*.
 func (t *Table) Complex128() (complex128, error) {
+	if err := t.check("Table.Complex128"); err != nil {
+		return 0i, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		return complex(float64(t.uint()), 0), nil
@@ -406,6 +514,10 @@ func (t *Table) Complex128() (complex128, error) {
 // Map returns t's underlying value as a map.
 // It returns error if t's kind is not Map, Array, Slice or Struct.
 func (t *Table) Map() (map[*Table]*Table, error) {
+	if err := t.check("Table.Map"); err != nil {
+		return nil, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Map:
 		return t.mapMap(), nil
@@ -414,7 +526,7 @@ func (t *Table) Map() (map[*Table]*Table, error) {
 	case reflect.Struct:
 		return t.structMap(), nil
 	case reflect.Interface, reflect.Ptr:
-		return (&Table{v: indirect(t.getv())}).Map()
+		return t.child(indirect(t.getv())).Map()
 	default:
 		return nil, &ErrUnsupportedKind{"Table.Map", t.getv().Kind()}
 	}
@@ -423,13 +535,17 @@ func (t *Table) Map() (map[*Table]*Table, error) {
 // Slice returns t's underlying value as a slice.
 // It returns error if t's kind is not Array, Slice or Struct.
 func (t *Table) Slice() ([]*Table, error) {
+	if err := t.check("Table.Slice"); err != nil {
+		return nil, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Array, reflect.Slice:
 		return t.sliceSlice(), nil
 	case reflect.Struct:
 		return t.structSlice(), nil
 	case reflect.Interface, reflect.Ptr:
-		return (&Table{v: indirect(t.getv())}).Slice()
+		return t.child(indirect(t.getv())).Slice()
 	default:
 		return nil, &ErrUnsupportedKind{"Table.Slice", t.getv().Kind()}
 	}
@@ -438,6 +554,10 @@ func (t *Table) Slice() ([]*Table, error) {
 // AList returns t's underlying value as an association list.
 // It returns error if t's kind is not Map, Array, Slice or Struct.
 func (t *Table) AList() ([][2]*Table, error) {
+	if err := t.check("Table.AList"); err != nil {
+		return nil, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Map:
 		return t.mapAList(), nil
@@ -446,7 +566,7 @@ func (t *Table) AList() ([][2]*Table, error) {
 	case reflect.Struct:
 		return t.structAList(), nil
 	case reflect.Interface, reflect.Ptr:
-		return (&Table{v: indirect(t.getv())}).AList()
+		return t.child(indirect(t.getv())).AList()
 	default:
 		return nil, &ErrUnsupportedKind{"Table.AList", t.getv().Kind()}
 	}
@@ -455,6 +575,10 @@ func (t *Table) AList() ([][2]*Table, error) {
 // PList returns t's underlying value as an property list.
 // It returns error if t's kind is not Map, Array, Slice or Struct.
 func (t *Table) PList() ([]*Table, error) {
+	if err := t.check("Table.PList"); err != nil {
+		return nil, err
+	}
+
 	switch t.getv().Kind() {
 	case reflect.Map:
 		return t.mapPList(), nil
@@ -463,23 +587,37 @@ func (t *Table) PList() ([]*Table, error) {
 	case reflect.Struct:
 		return t.structPList(), nil
 	case reflect.Interface, reflect.Ptr:
-		return (&Table{v: indirect(t.getv())}).PList()
+		return t.child(indirect(t.getv())).PList()
 	default:
 		return nil, &ErrUnsupportedKind{"Table.PList", t.getv().Kind()}
 	}
 }
 
+// Interface returns t's underlying value,
+// or the nil if t is the nil *Table or carries an error.
 func (t *Table) Interface() interface{} {
+	if t.check("Table.Interface") != nil {
+		return nil
+	}
 	return t.geti()
 }
 
+// Ptr returns t's underlying value as a pointer like reflect.Value.Pointer,
+// or 0 if t is the nil *Table or carries an error.
 func (t *Table) Ptr() uintptr {
+	if t.check("Table.Ptr") != nil {
+		return 0
+	}
 	return t.getv().Pointer()
 }
 
 var _StringerType = reflect.TypeOf((fmt.Stringer)(nil))
 
 func (t *Table) String() (string, error) {
+	if err := t.check("Table.String"); err != nil {
+		return "", err
+	}
+
 	// TODO: check if implement string
 
 	switch t.getv().Kind() {
@@ -514,48 +652,20 @@ func (t *Table) String() (string, error) {
 
 type eachDoFunc func(k, v *Table) error
 
-// EachDo ...
-func (t *Table) EachDo(f eachDoFunc) error {
-	switch t.getv().Kind() {
-	case reflect.Map, reflect.Array, reflect.Slice, reflect.Struct:
-		m, err := t.Map()
-		if err != nil {
-			return err
-		}
-		for k, v := range m {
-			if err := f(k, v); err != nil {
-				return err
-			}
-		}
-	case reflect.Chan:
-		idx := 0
-		for {
-			v, ok := t.getv().Recv()
-			if !ok {
-				break
-			}
-
-			if err := f(&Table{i: idx}, &Table{v: v}); err != nil {
-				return err
-			}
-
-			idx++
-		}
-	case reflect.Bool,
-		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-		reflect.Uintptr,
-		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
-
-		if err := f(nil, t); err != nil {
-			return err
-		}
-
-	case reflect.Interface, reflect.Ptr:
-		return (&Table{v: indirect(t.getv())}).EachDo(f)
-
-	default:
-		return &ErrUnsupportedKind{"Table.EachDo", t.getv().Kind()}
+// EachDo calls f with every key and value of t, until f returns an error.
+//
+// Arrays, slices and structs are iterated in index/field order,
+// maps are iterated in natural key order unless opts changes it,
+// channels are iterated until closed with indexes as keys,
+// and scalars are iterated as a value of the nil key.
+func (t *Table) EachDo(f eachDoFunc, opts ...EachOption) error {
+	var ferr error
+	err := t.each("Table.EachDo", newEachOptions(opts), func(k, v *Table) bool {
+		ferr = f(k, v)
+		return ferr == nil
+	})
+	if err != nil {
+		return err
 	}
-	return nil
+	return ferr
 }
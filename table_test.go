@@ -7,11 +7,11 @@ import (
 	"time"
 
 	. "github.com/onsi/ginkgo"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
-func ExpectErr(rets ...interface{}) Assertion {
-	return Expect(rets[1])
+func ExpectErr(rets ...interface{}) gomega.Assertion {
+	return gomega.Expect(rets[1])
 }
 
 var _ = Describe("Gets", func() {
@@ -19,35 +19,35 @@ var _ = Describe("Gets", func() {
 		Specify("from bool kind", func() {
 			b := true
 			t := New(b)
-			Expect(t.Bool()).To(Equal(b))
+			gomega.Expect(t.Bool()).To(gomega.Equal(b))
 		})
 		Specify("from bool ptr kind", func() {
 			b := true
 			t := New(&b)
-			Expect(t.Bool()).To(Equal(b))
+			gomega.Expect(t.Bool()).To(gomega.Equal(b))
 		})
 		Specify("from other kind", func() {
 			t := New("test")
-			ExpectErr(t.Bool()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.Bool()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Bytes()", func() {
 		Specify("from []byte type", func() {
 			x := []byte("abcd")
 			t := New(x)
-			Expect(t.Bytes()).To(Equal(x))
+			gomega.Expect(t.Bytes()).To(gomega.Equal(x))
 		})
 		Specify("from []byte ptr type", func() {
 			x := []byte("abcd")
 			t := New(&x)
-			Expect(t.Bytes()).To(Equal(x))
+			gomega.Expect(t.Bytes()).To(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			t := New("test")
-			ExpectErr(t.Bytes()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.Bytes()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 
 			t = New([]int{1, 2, 3})
-			ExpectErr(t.Bytes()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.Bytes()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Uint()", func() {
@@ -60,24 +60,24 @@ var _ = Describe("Gets", func() {
 				New(uint32(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Uint()).To(Equal(x))
+				gomega.Expect(t.Uint()).To(gomega.Equal(x))
 			}
 
 			ut64 := New(uint64(x))
 			if bits.UintSize == 64 {
-				Expect(ut64.Uint()).To(Equal(x))
+				gomega.Expect(ut64.Uint()).To(gomega.Equal(x))
 			} else {
-				ExpectErr(ut64.Uint()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+				ExpectErr(ut64.Uint()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := uint(1)
 			t := New(&x)
-			Expect(t.Uint()).Should(Equal(x))
+			gomega.Expect(t.Uint()).Should(gomega.Equal(x))
 		})
 		Specify("from other type", func() {
 			t := New("test")
-			ExpectErr(t.Uint()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.Uint()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Uint8()", func() {
@@ -87,17 +87,17 @@ var _ = Describe("Gets", func() {
 				New(uint8(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Uint8()).To(Equal(x))
+				gomega.Expect(t.Uint8()).To(gomega.Equal(x))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := uint8(1)
 			t := New(&x)
-			Expect(t.Uint8()).Should(Equal(x))
+			gomega.Expect(t.Uint8()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			st := New("test")
-			ExpectErr(st.Uint8()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(st.Uint8()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Uint16()", func() {
@@ -108,17 +108,17 @@ var _ = Describe("Gets", func() {
 				New(uint16(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Uint16()).To(Equal(x))
+				gomega.Expect(t.Uint16()).To(gomega.Equal(x))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := uint16(12)
 			t := New(&x)
-			Expect(t.Uint16()).Should(Equal(x))
+			gomega.Expect(t.Uint16()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			st := New("test")
-			ExpectErr(st.Uint16()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(st.Uint16()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Uint32()", func() {
@@ -130,24 +130,24 @@ var _ = Describe("Gets", func() {
 				New(uint32(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Uint32()).To(Equal(x))
+				gomega.Expect(t.Uint32()).To(gomega.Equal(x))
 			}
 
 			ut := New(uint(x))
 			if bits.UintSize == 32 {
-				Expect(ut.Uint32()).To(Equal(x))
+				gomega.Expect(ut.Uint32()).To(gomega.Equal(x))
 			} else {
-				ExpectErr(ut.Uint32()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+				ExpectErr(ut.Uint32()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := uint32(12)
 			t := New(&x)
-			Expect(t.Uint32()).Should(Equal(x))
+			gomega.Expect(t.Uint32()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			st := New("test")
-			ExpectErr(st.Uint32()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(st.Uint32()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Uint64()", func() {
@@ -161,17 +161,17 @@ var _ = Describe("Gets", func() {
 				New(uint64(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Uint64()).To(Equal(x))
+				gomega.Expect(t.Uint64()).To(gomega.Equal(x))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := uint64(12)
 			t := New(&x)
-			Expect(t.Uint64()).Should(Equal(x))
+			gomega.Expect(t.Uint64()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			st := New("test")
-			ExpectErr(st.Uint64()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(st.Uint64()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Int()", func() {
@@ -186,46 +186,46 @@ var _ = Describe("Gets", func() {
 				New(uint16(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Int()).To(Equal(x))
+				gomega.Expect(t.Int()).To(gomega.Equal(x))
 			}
 
 			t64 := New(int64(x))
 			if bits.UintSize == 64 {
-				Expect(t64.Int()).To(Equal(x))
+				gomega.Expect(t64.Int()).To(gomega.Equal(x))
 			} else {
-				ExpectErr(t64.Int()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+				ExpectErr(t64.Int()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 			}
 
 			ut32 := New(uint32(x))
 			if bits.UintSize == 64 {
-				Expect(ut32.Int()).To(Equal(x))
+				gomega.Expect(ut32.Int()).To(gomega.Equal(x))
 			} else {
-				ExpectErr(ut32.Int()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+				ExpectErr(ut32.Int()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := int(12)
 			t := New(&x)
-			Expect(t.Int()).Should(Equal(x))
+			gomega.Expect(t.Int()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			t := New("test")
-			ExpectErr(t.Int()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.Int()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Int8()", func() {
 		Specify("from int8 kind", func() {
 			t8 := New(int8(12))
-			Expect(t8.Int8()).To(Equal(int8(12)))
+			gomega.Expect(t8.Int8()).To(gomega.Equal(int8(12)))
 		})
 		Specify("from ptr kind", func() {
 			x := int8(12)
 			t := New(&x)
-			Expect(t.Int8()).Should(Equal(x))
+			gomega.Expect(t.Int8()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			s := New("test")
-			ExpectErr(s.Int8()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(s.Int8()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Int16()", func() {
@@ -237,17 +237,17 @@ var _ = Describe("Gets", func() {
 				New(uint8(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Int16()).To(Equal(x))
+				gomega.Expect(t.Int16()).To(gomega.Equal(x))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := int16(12)
 			t := New(&x)
-			Expect(t.Int16()).Should(Equal(x))
+			gomega.Expect(t.Int16()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			t := New("test")
-			ExpectErr(t.Int16()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.Int16()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Int32()", func() {
@@ -261,24 +261,24 @@ var _ = Describe("Gets", func() {
 				New(uint16(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Int32()).To(Equal(x))
+				gomega.Expect(t.Int32()).To(gomega.Equal(x))
 			}
 
 			t := New(int(x))
 			if bits.UintSize == 32 {
-				Expect(t.Int32()).To(Equal(x))
+				gomega.Expect(t.Int32()).To(gomega.Equal(x))
 			} else {
-				ExpectErr(t.Int32()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+				ExpectErr(t.Int32()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := int32(12)
 			t := New(&x)
-			Expect(t.Int32()).Should(Equal(x))
+			gomega.Expect(t.Int32()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			st := New("test")
-			ExpectErr(st.Int32()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(st.Int32()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Int64()", func() {
@@ -295,24 +295,24 @@ var _ = Describe("Gets", func() {
 				New(uint32(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Int64()).To(Equal(x))
+				gomega.Expect(t.Int64()).To(gomega.Equal(x))
 			}
 
 			ut := New(uint(x))
 			if bits.UintSize == 32 {
-				Expect(ut.Int64()).To(Equal(x))
+				gomega.Expect(ut.Int64()).To(gomega.Equal(x))
 			} else {
-				ExpectErr(ut.Int64()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+				ExpectErr(ut.Int64()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := int64(12)
 			t := New(&x)
-			Expect(t.Int64()).Should(Equal(x))
+			gomega.Expect(t.Int64()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			t := New("test")
-			ExpectErr(t.Int64()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.Int64()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Float32()", func() {
@@ -332,17 +332,17 @@ var _ = Describe("Gets", func() {
 				New(uint64(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Float32()).To(Equal(x))
+				gomega.Expect(t.Float32()).To(gomega.Equal(x))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := float32(12)
 			t := New(&x)
-			Expect(t.Float32()).Should(Equal(x))
+			gomega.Expect(t.Float32()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			st := New("test")
-			ExpectErr(st.Float32()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(st.Float32()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Float64()", func() {
@@ -363,17 +363,17 @@ var _ = Describe("Gets", func() {
 				New(uint64(x)),
 			}
 			for _, t := range ts {
-				Expect(t.Float64()).To(Equal(x))
+				gomega.Expect(t.Float64()).To(gomega.Equal(x))
 			}
 		})
 		Specify("from ptr kind", func() {
 			x := float64(12)
 			t := New(&x)
-			Expect(t.Float64()).Should(Equal(x))
+			gomega.Expect(t.Float64()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			st := New("test")
-			ExpectErr(st.Float64()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(st.Float64()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Complex64()", func() {
@@ -394,19 +394,19 @@ var _ = Describe("Gets", func() {
 				New(float32(r)),
 			}
 			for _, t := range ts {
-				Expect(t.Complex64()).To(Equal(complex(float32(r), 0)))
+				gomega.Expect(t.Complex64()).To(gomega.Equal(complex(float32(r), 0)))
 			}
 			tc := New(c)
-			Expect(tc.Complex64()).To(Equal(c))
+			gomega.Expect(tc.Complex64()).To(gomega.Equal(c))
 		})
 		Specify("from ptr kind", func() {
 			x := complex(float32(12), float32(13))
 			t := New(&x)
-			Expect(t.Complex64()).Should(Equal(x))
+			gomega.Expect(t.Complex64()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			st := New("test")
-			ExpectErr(st.Complex64()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(st.Complex64()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Complex128()", func() {
@@ -428,23 +428,23 @@ var _ = Describe("Gets", func() {
 				New(float64(r)),
 			}
 			for _, t := range ts {
-				Expect(t.Complex128()).To(Equal(complex(float64(r), 0)))
+				gomega.Expect(t.Complex128()).To(gomega.Equal(complex(float64(r), 0)))
 			}
 			tc := New(c)
-			Expect(tc.Complex128()).To(Equal(c))
+			gomega.Expect(tc.Complex128()).To(gomega.Equal(c))
 
 			c32 := complex(float32(12), float32(13))
 			tc32 := New(c32)
-			Expect(tc32.Complex128()).To(Equal(c))
+			gomega.Expect(tc32.Complex128()).To(gomega.Equal(c))
 		})
 		Specify("from ptr kind", func() {
 			x := complex(float64(12), float64(13))
 			t := New(&x)
-			Expect(t.Complex128()).Should(Equal(x))
+			gomega.Expect(t.Complex128()).Should(gomega.Equal(x))
 		})
 		Specify("from other kind", func() {
 			st := New("test")
-			ExpectErr(st.Complex128()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(st.Complex128()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Get()", func() {
@@ -455,25 +455,25 @@ var _ = Describe("Gets", func() {
 			}
 			t := New(m)
 			for k, v := range m {
-				Expect(t.MustGet(k).Int()).Should(Equal(v))
+				gomega.Expect(t.MustGet(k).Int()).Should(gomega.Equal(v))
 			}
-			Expect(t.MustGet(3)).Should(BeNil())
+			gomega.Expect(t.MustGet(3)).Should(gomega.BeNil())
 		})
 		Specify("from slice kind", func() {
 			s := []int{1, 2}
 			t := New(s)
 			for idx, elem := range s {
-				Expect(t.MustGet(idx).Int()).Should(Equal(elem))
+				gomega.Expect(t.MustGet(idx).Int()).Should(gomega.Equal(elem))
 			}
-			Expect(t.MustGet(3)).Should(BeNil())
+			gomega.Expect(t.MustGet(3)).Should(gomega.BeNil())
 		})
 		Specify("from array kind", func() {
 			s := [3]int{1, 2}
 			t := New(s)
 			for idx, elem := range s {
-				Expect(t.MustGet(idx).Int()).Should(Equal(elem))
+				gomega.Expect(t.MustGet(idx).Int()).Should(gomega.Equal(elem))
 			}
-			Expect(t.MustGet(4)).Should(BeNil())
+			gomega.Expect(t.MustGet(4)).Should(gomega.BeNil())
 		})
 		Specify("from struct kind", func() {
 			ss := struct {
@@ -483,18 +483,18 @@ var _ = Describe("Gets", func() {
 				B: 2,
 			}
 			t := New(ss)
-			Expect(t.MustGet("A").Int()).Should(Equal(ss.A))
-			Expect(t.MustGet("B").Int()).Should(Equal(ss.B))
-			Expect(t.MustGet("C")).Should(BeNil())
+			gomega.Expect(t.MustGet("A").Int()).Should(gomega.Equal(ss.A))
+			gomega.Expect(t.MustGet("B").Int()).Should(gomega.Equal(ss.B))
+			gomega.Expect(t.MustGet("C")).Should(gomega.BeNil())
 		})
 		Specify("from ptr kind", func() {
 			s := []int{1}
 			t := New(&s)
-			Expect(t.MustGet(0).Int()).Should(Equal(1))
+			gomega.Expect(t.MustGet(0).Int()).Should(gomega.Equal(1))
 		})
 		Specify("from other kind", func() {
 			t := New("test")
-			ExpectErr(t.Get("x")).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.Get("x")).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Map()", func() {
@@ -504,25 +504,25 @@ var _ = Describe("Gets", func() {
 				2: 2,
 			}
 			tm := New(m).MustMap()
-			Expect(len(tm)).Should(Equal(len(m)))
+			gomega.Expect(len(tm)).Should(gomega.Equal(len(m)))
 			for tk, tv := range tm {
-				Expect(tv.Int()).Should(Equal(m[tk.MustInt()]))
+				gomega.Expect(tv.Int()).Should(gomega.Equal(m[tk.MustInt()]))
 			}
 		})
 		Specify("from slice kind", func() {
 			s := []int{1, 2}
 			tm := New(s).MustMap()
-			Expect(len(tm)).Should(Equal(len(s)))
+			gomega.Expect(len(tm)).Should(gomega.Equal(len(s)))
 			for idx, elem := range tm {
-				Expect(elem.Int()).Should(Equal(s[idx.MustInt()]))
+				gomega.Expect(elem.Int()).Should(gomega.Equal(s[idx.MustInt()]))
 			}
 		})
 		Specify("from array kind", func() {
 			s := [3]int{1, 2}
 			tm := New(s).MustMap()
-			Expect(len(tm)).Should(Equal(len(s)))
+			gomega.Expect(len(tm)).Should(gomega.Equal(len(s)))
 			for idx, elem := range tm {
-				Expect(elem.Int()).Should(Equal(s[idx.MustInt()]))
+				gomega.Expect(elem.Int()).Should(gomega.Equal(s[idx.MustInt()]))
 			}
 		})
 		Specify("from struct kind", func() {
@@ -533,48 +533,48 @@ var _ = Describe("Gets", func() {
 				B: 2,
 			}
 			tm := New(ss).MustMap()
-			Expect(len(tm)).Should(Equal(2))
+			gomega.Expect(len(tm)).Should(gomega.Equal(2))
 			for tk, tv := range tm {
 				key, err := tk.String()
-				Expect(err).Should(BeNil())
+				gomega.Expect(err).Should(gomega.BeNil())
 				switch key {
 				case "A":
-					Expect(tv.Int()).Should(Equal(ss.A))
+					gomega.Expect(tv.Int()).Should(gomega.Equal(ss.A))
 				case "B":
-					Expect(tv.Int()).Should(Equal(ss.B))
+					gomega.Expect(tv.Int()).Should(gomega.Equal(ss.B))
 				default: // must error
-					Expect(tv.Int()).Should(BeNil())
+					gomega.Expect(tv.Int()).Should(gomega.BeNil())
 				}
 			}
 		})
 		Specify("from ptr kind", func() {
 			s := []int{1, 2}
 			tm := New(&s).MustMap()
-			Expect(len(tm)).Should(Equal(len(s)))
+			gomega.Expect(len(tm)).Should(gomega.Equal(len(s)))
 			for idx, elem := range tm {
-				Expect(elem.Int()).Should(Equal(s[idx.MustInt()]))
+				gomega.Expect(elem.Int()).Should(gomega.Equal(s[idx.MustInt()]))
 			}
 		})
 		Specify("from other kind", func() {
 			t := New("test")
-			ExpectErr(t.Map()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.Map()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with Slice()", func() {
 		Specify("from slice kind", func() {
 			s := []int{1, 2}
 			ts := New(s).MustSlice()
-			Expect(len(ts)).Should(Equal(len(s)))
+			gomega.Expect(len(ts)).Should(gomega.Equal(len(s)))
 			for idx, elem := range ts {
-				Expect(elem.Int()).Should(Equal(s[idx]))
+				gomega.Expect(elem.Int()).Should(gomega.Equal(s[idx]))
 			}
 		})
 		Specify("from array kind", func() {
 			s := [3]int{1, 2}
 			ts := New(s).MustSlice()
-			Expect(len(ts)).Should(Equal(len(s)))
+			gomega.Expect(len(ts)).Should(gomega.Equal(len(s)))
 			for idx, elem := range ts {
-				Expect(elem.Int()).Should(Equal(s[idx]))
+				gomega.Expect(elem.Int()).Should(gomega.Equal(s[idx]))
 			}
 		})
 		Specify("from struct kind", func() {
@@ -585,21 +585,21 @@ var _ = Describe("Gets", func() {
 				B: 2,
 			}
 			ts := New(ss).MustSlice()
-			Expect(len(ts)).Should(Equal(2))
-			Expect(ts[0].Int()).Should(Equal(ss.A))
-			Expect(ts[1].Int()).Should(Equal(ss.B))
+			gomega.Expect(len(ts)).Should(gomega.Equal(2))
+			gomega.Expect(ts[0].Int()).Should(gomega.Equal(ss.A))
+			gomega.Expect(ts[1].Int()).Should(gomega.Equal(ss.B))
 		})
 		Specify("from ptr kind", func() {
 			s := []int{1, 2}
 			ts := New(&s).MustSlice()
-			Expect(len(ts)).Should(Equal(len(s)))
+			gomega.Expect(len(ts)).Should(gomega.Equal(len(s)))
 			for idx, elem := range ts {
-				Expect(elem.Int()).Should(Equal(s[idx]))
+				gomega.Expect(elem.Int()).Should(gomega.Equal(s[idx]))
 			}
 		})
 		Specify("from other kind", func() {
 			t := New("test")
-			ExpectErr(t.Slice()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.Slice()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with AList()", func() {
@@ -609,25 +609,25 @@ var _ = Describe("Gets", func() {
 				2: 2,
 			}
 			tl := New(m).MustAList()
-			Expect(len(tl)).Should(Equal(len(m)))
+			gomega.Expect(len(tl)).Should(gomega.Equal(len(m)))
 			for _, kv := range tl {
-				Expect(kv[1].Int() /* value */).Should(Equal(m[kv[0].MustInt() /* key */]))
+				gomega.Expect(kv[1].Int() /* value */).Should(gomega.Equal(m[kv[0].MustInt() /* key */]))
 			}
 		})
 		Specify("from slice kind", func() {
 			s := []int{1, 2}
 			tl := New(s).MustAList()
-			Expect(len(tl)).Should(Equal(len(s)))
+			gomega.Expect(len(tl)).Should(gomega.Equal(len(s)))
 			for _, kv := range tl {
-				Expect(kv[1].Int() /* value */).Should(Equal(s[kv[0].MustInt() /* idx */]))
+				gomega.Expect(kv[1].Int() /* value */).Should(gomega.Equal(s[kv[0].MustInt() /* idx */]))
 			}
 		})
 		Specify("from array kind", func() {
 			s := [3]int{1, 2}
 			tl := New(s).MustAList()
-			Expect(len(tl)).Should(Equal(len(s)))
+			gomega.Expect(len(tl)).Should(gomega.Equal(len(s)))
 			for _, kv := range tl {
-				Expect(kv[1].Int() /* value */).Should(Equal(s[kv[0].MustInt() /* idx */]))
+				gomega.Expect(kv[1].Int() /* value */).Should(gomega.Equal(s[kv[0].MustInt() /* idx */]))
 			}
 		})
 		Specify("from struct kind", func() {
@@ -638,25 +638,25 @@ var _ = Describe("Gets", func() {
 				B: 2,
 			}
 			tl := New(ss).MustAList()
-			Expect(len(tl)).Should(Equal(2))
+			gomega.Expect(len(tl)).Should(gomega.Equal(2))
 
-			Expect(tl[0][0].String() /* field name */).Should(Equal("A"))
-			Expect(tl[1][0].String() /* field name */).Should(Equal("B"))
+			gomega.Expect(tl[0][0].String() /* field name */).Should(gomega.Equal("A"))
+			gomega.Expect(tl[1][0].String() /* field name */).Should(gomega.Equal("B"))
 
-			Expect(tl[0][1].Int() /* value */).Should(Equal(ss.A))
-			Expect(tl[1][1].Int() /* value */).Should(Equal(ss.B))
+			gomega.Expect(tl[0][1].Int() /* value */).Should(gomega.Equal(ss.A))
+			gomega.Expect(tl[1][1].Int() /* value */).Should(gomega.Equal(ss.B))
 		})
 		Specify("from ptr kind", func() {
 			s := []int{1, 2}
 			tl := New(&s).MustAList()
-			Expect(len(tl)).Should(Equal(len(s)))
+			gomega.Expect(len(tl)).Should(gomega.Equal(len(s)))
 			for _, kv := range tl {
-				Expect(kv[1].Int() /* value */).Should(Equal(s[kv[0].MustInt() /* idx */]))
+				gomega.Expect(kv[1].Int() /* value */).Should(gomega.Equal(s[kv[0].MustInt() /* idx */]))
 			}
 		})
 		Specify("from other kind", func() {
 			t := New("test")
-			ExpectErr(t.AList()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.AList()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with PList()", func() {
@@ -666,31 +666,31 @@ var _ = Describe("Gets", func() {
 				2: 2,
 			}
 			tl := New(m).MustPList()
-			Expect(len(tl)).Should(Equal(2 * len(m)))
+			gomega.Expect(len(tl)).Should(gomega.Equal(2 * len(m)))
 			for i := 0; i < len(tl)/2; i += 2 {
 				k := tl[i]
 				v := tl[i+1]
-				Expect(v.Int()).Should(Equal(m[k.MustInt()]))
+				gomega.Expect(v.Int()).Should(gomega.Equal(m[k.MustInt()]))
 			}
 		})
 		Specify("from slice kind", func() {
 			s := []int{1, 2}
 			tl := New(s).MustPList()
-			Expect(len(tl)).Should(Equal(2 * len(s)))
+			gomega.Expect(len(tl)).Should(gomega.Equal(2 * len(s)))
 			for i := 0; i < len(tl); i += 2 {
 				k := tl[i]
 				v := tl[i+1]
-				Expect(v.Int()).Should(Equal(s[k.MustInt()]))
+				gomega.Expect(v.Int()).Should(gomega.Equal(s[k.MustInt()]))
 			}
 		})
 		Specify("from array kind", func() {
 			s := [3]int{1, 2}
 			tl := New(s).MustPList()
-			Expect(len(tl)).Should(Equal(2 * len(s)))
+			gomega.Expect(len(tl)).Should(gomega.Equal(2 * len(s)))
 			for i := 0; i < len(tl); i += 2 {
 				k := tl[i]
 				v := tl[i+1]
-				Expect(v.Int()).Should(Equal(s[k.MustInt()]))
+				gomega.Expect(v.Int()).Should(gomega.Equal(s[k.MustInt()]))
 			}
 		})
 		Specify("from struct kind", func() {
@@ -701,64 +701,64 @@ var _ = Describe("Gets", func() {
 				B: 2,
 			}
 			tl := New(ss).MustPList()
-			Expect(len(tl)).Should(Equal(2 * 2))
-			Expect(tl[0].String() /* field name */).Should(Equal("A"))
-			Expect(tl[1].Int() /* value */).Should(Equal(ss.A))
-			Expect(tl[2].String() /* field name */).Should(Equal("B"))
-			Expect(tl[3].Int() /* value */).Should(Equal(ss.B))
+			gomega.Expect(len(tl)).Should(gomega.Equal(2 * 2))
+			gomega.Expect(tl[0].String() /* field name */).Should(gomega.Equal("A"))
+			gomega.Expect(tl[1].Int() /* value */).Should(gomega.Equal(ss.A))
+			gomega.Expect(tl[2].String() /* field name */).Should(gomega.Equal("B"))
+			gomega.Expect(tl[3].Int() /* value */).Should(gomega.Equal(ss.B))
 		})
 		Specify("from ptr kind", func() {
 			s := []int{1, 2}
 			tl := New(&s).MustPList()
-			Expect(len(tl)).Should(Equal(2 * len(s)))
+			gomega.Expect(len(tl)).Should(gomega.Equal(2 * len(s)))
 			for i := 0; i < len(tl); i += 2 {
 				k := tl[i]
 				v := tl[i+1]
-				Expect(v.Int()).Should(Equal(s[k.MustInt()]))
+				gomega.Expect(v.Int()).Should(gomega.Equal(s[k.MustInt()]))
 			}
 		})
 		Specify("from other kind", func() {
 			t := New("test")
-			ExpectErr(t.PList()).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(t.PList()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 	Context("with String()", func() {
 		Specify("bool kind", func() {
 			b := true
 			tb := New(b)
-			Expect(tb.String()).To(Equal("true"))
+			gomega.Expect(tb.String()).To(gomega.Equal("true"))
 
 			b = false
 			tb = New(b)
-			Expect(tb.String()).To(Equal("false"))
+			gomega.Expect(tb.String()).To(gomega.Equal("false"))
 		})
 		Specify("int* kind", func() {
 			x := 123
 			t := New(x)
-			Expect(t.String()).Should(Equal("123"))
+			gomega.Expect(t.String()).Should(gomega.Equal("123"))
 		})
 		Specify("uint* kind", func() {
 			x := uint(123)
 			t := New(x)
-			Expect(t.String()).Should(Equal("123"))
+			gomega.Expect(t.String()).Should(gomega.Equal("123"))
 		})
 		Specify("float* kind", func() {
 			x := 1.2
 			t := New(x)
-			Expect(t.String()).Should(Equal("1.2"))
+			gomega.Expect(t.String()).Should(gomega.Equal("1.2"))
 
 			x = 1.2e+34
 			t = New(x)
-			Expect(t.String()).Should(Equal("1.2e+34"))
+			gomega.Expect(t.String()).Should(gomega.Equal("1.2e+34"))
 		})
 		Specify("complex* kind", func() {
 			x := 1 + 2i
 			t := New(x)
-			Expect(t.String()).Should(Equal("(1+2i)"))
+			gomega.Expect(t.String()).Should(gomega.Equal("(1+2i)"))
 
 			x = -1.2e+34i + 1.2e+34
 			t = New(x)
-			Expect(t.String()).Should(Equal("(1.2e+34-1.2e+34i)"))
+			gomega.Expect(t.String()).Should(gomega.Equal("(1.2e+34-1.2e+34i)"))
 		})
 	})
 })
@@ -769,24 +769,24 @@ var _ = Describe("Sets", func() {
 			m := 123
 			t := New(&m)
 			err := t.Set(2)
-			Expect(err).Should(BeNil())
-			Expect(t.Int()).Should(Equal(2))
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(t.Int()).Should(gomega.Equal(2))
 
 			p := &m
 			n := 456
 			t = New(&p)
 			err = t.Set(&n)
-			Expect(err).Should(BeNil())
-			Expect(t.Int()).Should(Equal(456))
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(t.Int()).Should(gomega.Equal(456))
 		})
 		Specify("value can't set", func() {
 			t := New(1)
-			Expect(t.Set(2)).To(BeAssignableToTypeOf((*ErrCannotSet)(nil)))
+			gomega.Expect(t.Set(2)).To(gomega.BeAssignableToTypeOf((*ErrCannotSet)(nil)))
 		})
 		Specify("value kind unequal", func() {
 			x := 1
 			tx := New(&x)
-			Expect(tx.Set(1.2)).To(BeAssignableToTypeOf((*ErrTypeUnequal)(nil)))
+			gomega.Expect(tx.Set(1.2)).To(gomega.BeAssignableToTypeOf((*ErrTypeUnequal)(nil)))
 		})
 	})
 
@@ -798,34 +798,34 @@ var _ = Describe("Sets", func() {
 			}
 
 			tx := New(x)
-			Expect(tx.Put("A", 2)).Should(BeNil())
-			Expect(tx.Put("C", 1.2)).Should(BeNil())
+			gomega.Expect(tx.Put("A", 2)).Should(gomega.BeNil())
+			gomega.Expect(tx.Put("C", 1.2)).Should(gomega.BeNil())
 
-			Expect(tx.MustGet("A").Int()).Should(Equal(2))
-			Expect(tx.MustGet("B").String()).Should(Equal("b"))
-			Expect(tx.MustGet("C").Float64()).Should(Equal(1.2))
+			gomega.Expect(tx.MustGet("A").Int()).Should(gomega.Equal(2))
+			gomega.Expect(tx.MustGet("B").String()).Should(gomega.Equal("b"))
+			gomega.Expect(tx.MustGet("C").Float64()).Should(gomega.Equal(1.2))
 		})
 		Specify("to slice kind", func() {
 			x := []interface{}{1, "b"}
 
 			tx := New(x)
-			Expect(tx.Put(0, 2)).Should(BeNil())
-			Expect(tx.Put(2, 1.2)).Should(BeNil())
+			gomega.Expect(tx.Put(0, 2)).Should(gomega.BeNil())
+			gomega.Expect(tx.Put(2, 1.2)).Should(gomega.BeNil())
 
-			Expect(tx.MustGet(0).Int()).Should(Equal(2))
-			Expect(tx.MustGet(1).String()).Should(Equal("b"))
-			Expect(tx.MustGet(2).Float64()).Should(Equal(1.2))
+			gomega.Expect(tx.MustGet(0).Int()).Should(gomega.Equal(2))
+			gomega.Expect(tx.MustGet(1).String()).Should(gomega.Equal("b"))
+			gomega.Expect(tx.MustGet(2).Float64()).Should(gomega.Equal(1.2))
 		})
 		Specify("to array kind", func() {
 			x := [3]interface{}{1, "b"}
 
 			tx := New(&x)
-			Expect(tx.Put(0, 2)).Should(BeNil())
-			Expect(tx.Put(2, 1.2)).Should(BeNil())
+			gomega.Expect(tx.Put(0, 2)).Should(gomega.BeNil())
+			gomega.Expect(tx.Put(2, 1.2)).Should(gomega.BeNil())
 
-			Expect(tx.MustGet(0).Int()).Should(Equal(2))
-			Expect(tx.MustGet(1).String()).Should(Equal("b"))
-			Expect(tx.MustGet(2).Float64()).Should(Equal(1.2))
+			gomega.Expect(tx.MustGet(0).Int()).Should(gomega.Equal(2))
+			gomega.Expect(tx.MustGet(1).String()).Should(gomega.Equal("b"))
+			gomega.Expect(tx.MustGet(2).Float64()).Should(gomega.Equal(1.2))
 		})
 		Specify("to struct kind", func() {
 			x := struct {
@@ -835,20 +835,20 @@ var _ = Describe("Sets", func() {
 			}{1, "b", ""}
 
 			tx := New(&x)
-			Expect(tx.Put("A", 2)).Should(BeNil())
-			Expect(tx.Put("C", "c")).Should(BeNil())
+			gomega.Expect(tx.Put("A", 2)).Should(gomega.BeNil())
+			gomega.Expect(tx.Put("C", "c")).Should(gomega.BeNil())
 
-			Expect(tx.MustGet("A").Int()).Should(Equal(2))
-			Expect(tx.MustGet("B").String()).Should(Equal("b"))
-			Expect(tx.MustGet("C").String()).Should(Equal("c"))
+			gomega.Expect(tx.MustGet("A").Int()).Should(gomega.Equal(2))
+			gomega.Expect(tx.MustGet("B").String()).Should(gomega.Equal("b"))
+			gomega.Expect(tx.MustGet("C").String()).Should(gomega.Equal("c"))
 		})
 		Specify("to other kind", func() {
 			tx := New("a")
-			Expect(tx.Put("nil", "nil")).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			gomega.Expect(tx.Put("nil", "nil")).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 
 			x := 123
 			tx = New(&x)
-			Expect(tx.Put("nil", "nil")).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			gomega.Expect(tx.Put("nil", "nil")).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 		})
 	})
 })
@@ -862,7 +862,7 @@ var _ = Describe("Dos", func() {
 			}
 			t := New(m)
 			t.EachDo(func(k, v *Table) error {
-				Expect(m[k.MustInt()]).Should(Equal(v.MustInt()))
+				gomega.Expect(m[k.MustInt()]).Should(gomega.Equal(v.MustInt()))
 				return nil
 			})
 		})
@@ -876,7 +876,7 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(y)
-		Expect(err).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+		gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 	})
 	Specify("bool kind", func() {
 		x := true
@@ -884,8 +884,8 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
-		Expect(y).Should(Equal(x))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(y).Should(gomega.Equal(x))
 	})
 	Specify("int kind", func() {
 		x := 123
@@ -893,8 +893,8 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
-		Expect(y).Should(Equal(x))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(y).Should(gomega.Equal(x))
 	})
 	Specify("uint kind", func() {
 		x := uint(123)
@@ -902,8 +902,8 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
-		Expect(y).Should(Equal(uint(x)))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(y).Should(gomega.Equal(uint(x)))
 	})
 	Specify("float kind", func() {
 		x := 123.4
@@ -911,8 +911,8 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
-		Expect(y).Should(Equal(x))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(y).Should(gomega.Equal(x))
 	})
 	Specify("complex kind", func() {
 		x := 1i + 2
@@ -920,8 +920,8 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
-		Expect(y).Should(Equal(x))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(y).Should(gomega.Equal(x))
 	})
 	Specify("string kind", func() {
 		x := "abc"
@@ -929,8 +929,8 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
-		Expect(y).Should(Equal(x))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(y).Should(gomega.Equal(x))
 	})
 	Specify("slice kind", func() {
 		x := []interface{}{
@@ -941,10 +941,10 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
-		Expect(len(y)).Should(Equal(len(x)))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(len(y)).Should(gomega.Equal(len(x)))
 		for i, e := range y {
-			Expect(e).Should(Equal(x[i]))
+			gomega.Expect(e).Should(gomega.Equal(x[i]))
 		}
 	})
 	Specify("array kind", func() {
@@ -953,10 +953,10 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
+		gomega.Expect(err).Should(gomega.BeNil())
 		for i, v := range x {
 			fmt.Fprintf(GinkgoWriter, "%d, %v", i, y[i])
-			Expect(y[i]).Should(Equal(v))
+			gomega.Expect(y[i]).Should(gomega.Equal(v))
 		}
 	})
 	Specify("map kind", func() {
@@ -970,10 +970,10 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
+		gomega.Expect(err).Should(gomega.BeNil())
 		for k, v := range x {
 			fmt.Fprintf(GinkgoWriter, "%s, %v", k, y[k])
-			Expect(y[k]).Should(Equal(v))
+			gomega.Expect(y[k]).Should(gomega.Equal(v))
 		}
 	})
 	Specify("to struct kind", func() {
@@ -996,10 +996,10 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
-		Expect(y.A).Should(Equal(1))
-		Expect(y.B).Should(Equal(""))
-		Expect(y.C).Should(Equal("c"))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(y.A).Should(gomega.Equal(1))
+		gomega.Expect(y.B).Should(gomega.Equal(""))
+		gomega.Expect(y.C).Should(gomega.Equal("c"))
 		fmt.Fprint(GinkgoWriter, y.A)
 	})
 
@@ -1009,8 +1009,8 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
-		Expect(y).Should(Equal(1 * time.Second))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(y).Should(gomega.Equal(1 * time.Second))
 	})
 
 	Specify("time.Time type", func() {
@@ -1021,8 +1021,8 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
-		Expect(y.Format(TimeLayout)).Should(Equal(x))
+		gomega.Expect(err).Should(gomega.BeNil())
+		gomega.Expect(y.Format(TimeLayout)).Should(gomega.Equal(x))
 	})
 
 	Specify("nest struct kind", func() {
@@ -1048,7 +1048,7 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).Should(BeNil())
+		gomega.Expect(err).Should(gomega.BeNil())
 		// Expect(y.A).Should(Equal(1))
 		// Expect(y.B).Should(Equal(""))
 		// Expect(y.C).Should(Equal("c"))
@@ -1063,7 +1063,7 @@ var _ = Describe("ConvTo", func() {
 
 		tx := New(x)
 		err := tx.ConvTo(&y)
-		Expect(err).To(BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+		gomega.Expect(err).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 	})
 })
 
@@ -1071,108 +1071,158 @@ var _ = Describe("Musts", func() {
 	Specify("with MustInt8()", func() {
 		x := int8(1)
 		t := New(x)
-		Expect(t.MustInt8()).To(Equal(x))
+		gomega.Expect(t.MustInt8()).To(gomega.Equal(x))
 
 		t = New("test")
-		Expect(func() { t.MustInt8() }).Should(Panic())
+		gomega.Expect(func() { t.MustInt8() }).Should(gomega.Panic())
 	})
 	Specify("with MustInt16()", func() {
 		x := int16(1)
 		t := New(x)
-		Expect(t.MustInt16()).To(Equal(x))
+		gomega.Expect(t.MustInt16()).To(gomega.Equal(x))
 
 		t = New("test")
-		Expect(func() { t.MustInt16() }).Should(Panic())
+		gomega.Expect(func() { t.MustInt16() }).Should(gomega.Panic())
 	})
 	Specify("with MustInt32()", func() {
 		x := int32(1)
 		t := New(x)
-		Expect(t.MustInt32()).To(Equal(x))
+		gomega.Expect(t.MustInt32()).To(gomega.Equal(x))
 
 		t = New("test")
-		Expect(func() { t.MustInt32() }).Should(Panic())
+		gomega.Expect(func() { t.MustInt32() }).Should(gomega.Panic())
 	})
 	Specify("with MustInt64()", func() {
 		x := int64(1)
 		t := New(x)
-		Expect(t.MustInt64()).To(Equal(x))
+		gomega.Expect(t.MustInt64()).To(gomega.Equal(x))
 
 		t = New("test")
-		Expect(func() { t.MustInt64() }).Should(Panic())
+		gomega.Expect(func() { t.MustInt64() }).Should(gomega.Panic())
 	})
 	Specify("with MustInt()", func() {
 		x := int(1)
 		t := New(x)
-		Expect(t.MustInt()).To(Equal(x))
+		gomega.Expect(t.MustInt()).To(gomega.Equal(x))
 
 		t = New("test")
-		Expect(func() { t.MustInt() }).Should(Panic())
+		gomega.Expect(func() { t.MustInt() }).Should(gomega.Panic())
 	})
 	Specify("with MustFloat32()", func() {
 		x := float32(1)
 		t := New(x)
-		Expect(t.MustFloat32()).To(Equal(x))
+		gomega.Expect(t.MustFloat32()).To(gomega.Equal(x))
 
 		t = New("test")
-		Expect(func() { t.MustFloat32() }).Should(Panic())
+		gomega.Expect(func() { t.MustFloat32() }).Should(gomega.Panic())
 	})
 	Specify("with MustFloat64()", func() {
 		x := float64(1)
 		t := New(x)
-		Expect(t.MustFloat64()).To(Equal(x))
+		gomega.Expect(t.MustFloat64()).To(gomega.Equal(x))
 
 		t = New("test")
-		Expect(func() { t.MustFloat64() }).Should(Panic())
+		gomega.Expect(func() { t.MustFloat64() }).Should(gomega.Panic())
 	})
 	Specify("with MustGet()", func() {
 		x := map[int]int{1: 1}
 		t := New(x)
-		Expect(t.MustGet(1).Int()).To(Equal(1))
+		gomega.Expect(t.MustGet(1).Int()).To(gomega.Equal(1))
 
 		t = New("test")
-		Expect(func() { t.MustGet(1) }).Should(Panic())
+		gomega.Expect(func() { t.MustGet(1) }).Should(gomega.Panic())
 	})
 	Specify("with MustMap()", func() {
 		x := map[int]int{1: 1}
 		t := New(x)
 		m := t.MustMap()
 		for k, v := range m {
-			Expect(v.MustInt()).To(Equal(x[k.MustInt()]))
+			gomega.Expect(v.MustInt()).To(gomega.Equal(x[k.MustInt()]))
 		}
 
 		t = New("test")
-		Expect(func() { t.MustMap() }).Should(Panic())
+		gomega.Expect(func() { t.MustMap() }).Should(gomega.Panic())
 	})
 	Specify("with MustSlice()", func() {
 		x := []int{1, 2}
 		t := New(x)
 		s := t.MustSlice()
 		for i, v := range s {
-			Expect(v.MustInt()).To(Equal(x[i]))
+			gomega.Expect(v.MustInt()).To(gomega.Equal(x[i]))
 		}
 
 		t = New("test")
-		Expect(func() { t.MustSlice() }).Should(Panic())
+		gomega.Expect(func() { t.MustSlice() }).Should(gomega.Panic())
 	})
 	Specify("with MustAList()", func() {
 		x := map[int]int{1: 1}
 		t := New(x)
 		m := t.MustAList()
 		for _, kv := range m {
-			Expect(kv[1].MustInt()).To(Equal(x[kv[0].MustInt()]))
+			gomega.Expect(kv[1].MustInt()).To(gomega.Equal(x[kv[0].MustInt()]))
 		}
 
 		t = New("test")
-		Expect(func() { t.MustAList() }).Should(Panic())
+		gomega.Expect(func() { t.MustAList() }).Should(gomega.Panic())
 	})
 	Specify("with MustPList()", func() {
 		x := map[int]int{1: 1}
 		t := New(x)
 		p := t.MustPList()
-		Expect(p[1].MustInt()).To(Equal(x[p[0].MustInt()]))
+		gomega.Expect(p[1].MustInt()).To(gomega.Equal(x[p[0].MustInt()]))
 
 		t = New("test")
-		Expect(func() { t.MustPList() }).Should(Panic())
+		gomega.Expect(func() { t.MustPList() }).Should(gomega.Panic())
+	})
+})
+
+var _ = Describe("More Musts", func() {
+	Specify("with scalar getters", func() {
+		gomega.Expect(New(true).MustBool()).To(gomega.BeTrue())
+		gomega.Expect(New("s").MustString()).To(gomega.Equal("s"))
+		gomega.Expect(New([]byte("b")).MustBytes()).To(gomega.Equal([]byte("b")))
+		gomega.Expect(New(uint(1)).MustUint()).To(gomega.Equal(uint(1)))
+		gomega.Expect(New(uint8(1)).MustUint8()).To(gomega.Equal(uint8(1)))
+		gomega.Expect(New(uint16(1)).MustUint16()).To(gomega.Equal(uint16(1)))
+		gomega.Expect(New(uint32(1)).MustUint32()).To(gomega.Equal(uint32(1)))
+		gomega.Expect(New(uint64(1)).MustUint64()).To(gomega.Equal(uint64(1)))
+		gomega.Expect(New(complex64(1i)).MustComplex64()).To(gomega.Equal(complex64(1i)))
+		gomega.Expect(New(1i).MustComplex128()).To(gomega.Equal(1i))
+
+		t := New(func() {})
+		gomega.Expect(func() { t.MustBool() }).Should(gomega.Panic())
+		gomega.Expect(func() { t.MustString() }).Should(gomega.Panic())
+		gomega.Expect(func() { t.MustBytes() }).Should(gomega.Panic())
+		gomega.Expect(func() { t.MustUint() }).Should(gomega.Panic())
+		gomega.Expect(func() { t.MustComplex128() }).Should(gomega.Panic())
+	})
+	Specify("with MustPut(), MustSet() and MustConvTo()", func() {
+		x := map[string]int{}
+		New(x).MustPut("a", 1)
+		gomega.Expect(x["a"]).To(gomega.Equal(1))
+
+		i := 1
+		New(&i).MustSet(2)
+		gomega.Expect(i).To(gomega.Equal(2))
+
+		var y map[string]int
+		New(x).MustConvTo(&y)
+		gomega.Expect(y).To(gomega.Equal(x))
+
+		gomega.Expect(func() { New(1).MustPut("a", 1) }).Should(gomega.Panic())
+		gomega.Expect(func() { New(1).MustSet(2) }).Should(gomega.Panic())
+		gomega.Expect(func() { New(1).MustConvTo(1) }).Should(gomega.Panic())
+	})
+	Specify("panicking with the path", func() {
+		t := New(map[string]interface{}{"a": []interface{}{"x"}})
+		var r interface{}
+		func() {
+			defer func() { r = recover() }()
+			t.MustGetPath("a").MustGet(0).MustInt()
+		}()
+		gomega.Expect(r).To(gomega.BeAssignableToTypeOf((*ErrPath)(nil)))
+		gomega.Expect(r.(*ErrPath).Path).To(gomega.Equal(Path{"a", 0}))
+		gomega.Expect(r.(*ErrPath).Err).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
 	})
 })
 
@@ -1181,50 +1231,90 @@ var _ = Describe("Errs", func() {
 		m := "method"
 		k := reflect.Int
 		es := "table: call of " + m + " overflows " + k.String()
-		Expect((&ErrNumOverflow{m, k}).Error()).To(Equal(es))
+		gomega.Expect((&ErrNumOverflow{m, k}).Error()).To(gomega.Equal(es))
 	})
 	Specify("of ErrCannotBeNil", func() {
 		m := "method"
 		es := "table: call of " + m + " on nil value"
-		Expect((&ErrCannotBeNil{m}).Error()).To(Equal(es))
+		gomega.Expect((&ErrCannotBeNil{m}).Error()).To(gomega.Equal(es))
 	})
 	Specify("of ErrNotExist", func() {
 		m := "method"
 		k := "Int"
 		es := "table: call of " + m + " not exist of " + k
-		Expect((&ErrNotExist{m, k}).Error()).To(Equal(es))
+		gomega.Expect((&ErrNotExist{m, k}).Error()).To(gomega.Equal(es))
 	})
 	Specify("of ErrCannotSet", func() {
 		m := "method"
 		es := "table: call of " + m + " on unaddressable value"
-		Expect((&ErrCannotSet{m}).Error()).To(Equal(es))
+		gomega.Expect((&ErrCannotSet{m}).Error()).To(gomega.Equal(es))
 	})
 	Specify("of ErrNumOverflow", func() {
 		m := "method"
 		k := reflect.Int
 		es := "table: call of " + m + " overflows " + k.String()
-		Expect((&ErrNumOverflow{m, k}).Error()).To(Equal(es))
+		gomega.Expect((&ErrNumOverflow{m, k}).Error()).To(gomega.Equal(es))
 	})
 	Specify("of ErrTypeUnequal", func() {
 		m := "method"
 		k1 := reflect.Int
 		k2 := reflect.Float32
 		es := "table: call of " + m + " between " + k1.String() + " and " + k2.String()
-		Expect((&ErrTypeUnequal{m, k1, k2}).Error()).To(Equal(es))
+		gomega.Expect((&ErrTypeUnequal{m, k1, k2}).Error()).To(gomega.Equal(es))
 	})
 	Specify("of ErrOutOfRange", func() {
 		m := "method"
 		es := "table: call of " + m + " out of range"
-		Expect((&ErrOutOfRange{m}).Error()).To(Equal(es))
+		gomega.Expect((&ErrOutOfRange{m}).Error()).To(gomega.Equal(es))
+	})
+	Specify("of ErrTimeout", func() {
+		m := "method"
+		es := "table: call of " + m + " timed out"
+		gomega.Expect((&ErrTimeout{m}).Error()).To(gomega.Equal(es))
+	})
+	Specify("of ErrClosed", func() {
+		m := "method"
+		es := "table: call of " + m + " on closed channel"
+		gomega.Expect((&ErrClosed{m}).Error()).To(gomega.Equal(es))
+	})
+	Specify("of ErrConflict", func() {
+		m := "method"
+		k := "a key"
+		es := "table: call of " + m + " conflicts on " + k
+		gomega.Expect((&ErrConflict{m, k}).Error()).To(gomega.Equal(es))
+	})
+	Specify("of ErrSyntax", func() {
+		m := "method"
+		es := "table: call of " + m + " syntax error at 3: unexpected end"
+		gomega.Expect((&ErrSyntax{m, 3, "unexpected end"}).Error()).To(gomega.Equal(es))
+	})
+	Specify("of ErrEval", func() {
+		e := &ErrOutOfRange{"method"}
+		es := "table: eval at 4: call of method out of range"
+		gomega.Expect((&ErrEval{4, e}).Error()).To(gomega.Equal(es))
+		gomega.Expect((&ErrEval{4, e}).Unwrap()).To(gomega.Equal(e))
+	})
+	Specify("of ErrPath", func() {
+		p := Path{"a", 0}
+		e := &ErrOutOfRange{"method"}
+		es := "table: at a.0: call of method out of range"
+		gomega.Expect((&ErrPath{p, e}).Error()).To(gomega.Equal(es))
+		gomega.Expect((&ErrPath{p, e}).Unwrap()).To(gomega.Equal(e))
+	})
+	Specify("of ErrMulti", func() {
+		m := "method"
+		errs := []error{&ErrTimeout{"a"}, &ErrOutOfRange{"b"}}
+		es := "table: call of " + m + " failed: " + errs[0].Error() + "; " + errs[1].Error()
+		gomega.Expect((&ErrMulti{m, errs}).Error()).To(gomega.Equal(es))
 	})
 	Specify("of ErrUnsupportedKind", func() {
 		m := "method"
 		k := reflect.Int
 		es := "table: call of " + m + " on " + k.String() + " value"
-		Expect((&ErrUnsupportedKind{m, k}).Error()).To(Equal(es))
+		gomega.Expect((&ErrUnsupportedKind{m, k}).Error()).To(gomega.Equal(es))
 
 		ks := "type"
 		es = "table: call of " + m + " on " + ks + " value"
-		Expect((&ErrUnsupportedKind{m, ks}).Error()).To(Equal(es))
+		gomega.Expect((&ErrUnsupportedKind{m, ks}).Error()).To(gomega.Equal(es))
 	})
 })
@@ -0,0 +1,216 @@
+package table
+
+import (
+	"reflect"
+)
+
+// TransformFunc is the function called by Transform for every node,
+// it returns the replacement of v and true, or false to keep v.
+type TransformFunc func(path Path, v *Table) (interface{}, bool)
+
+var (
+	_InterfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+	_StringType    = reflect.TypeOf("")
+)
+
+// Transform returns a new Table with t's value rebuilt bottom-up,
+// every node is replaced by what f returns for it.
+//
+// f sees a node after its children are transformed. The original value is not
+// changed, unchanged subtrees are shared with it. A rebuilt map, array, slice or
+// struct keeps its type while the replacements are assignable to it, otherwise
+// a map becomes a map of interface{} values, an array or slice becomes a
+// []interface{} and a struct becomes a map[string]interface{} of its exported
+// fields. Nodes are the same as of Walk.
+func (t *Table) Transform(f TransformFunc) *Table {
+	if t.check("Table.Transform") != nil {
+		return t
+	}
+
+	tr := &transformer{f: f, seen: map[walkPtr]bool{}, frozen: t.frozen}
+	v, _ := tr.transform(Path{}, t.getv())
+	if t.frozen {
+		return frozenTable(v)
+	}
+	return valueTable(v)
+}
+
+type transformer struct {
+	f    TransformFunc
+	seen map[walkPtr]bool
+
+	// frozen is of the Table transformed, the nodes are frozen too.
+	frozen bool
+}
+
+// transform returns the transformed v and whether it's changed.
+func (tr *transformer) transform(path Path, v reflect.Value) (reflect.Value, bool) {
+	nv, changed := tr.rebuild(path, v)
+	node := valueTable(nv)
+	node.frozen = tr.frozen
+	if x, ok := tr.f(path, node); ok {
+		return reflect.ValueOf(x), true
+	}
+	return nv, changed
+}
+
+// rebuild returns v with its children transformed and whether it's changed.
+func (tr *transformer) rebuild(path Path, v reflect.Value) (reflect.Value, bool) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, false
+		}
+		return tr.rebuild(path, v.Elem())
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v, false
+		}
+		p := walkPtr{v.Type(), v.Pointer()}
+		if tr.seen[p] {
+			return v, false
+		}
+		tr.seen[p] = true
+		defer delete(tr.seen, p)
+
+		ne, changed := tr.rebuild(path, v.Elem())
+		if !changed {
+			return v, false
+		}
+		if ae, ok := assignable(ne, v.Type().Elem()); ok {
+			nv := reflect.New(v.Type().Elem())
+			nv.Elem().Set(ae)
+			return nv, true
+		}
+		return ne, true
+
+	case reflect.Map:
+		return tr.rebuildMap(path, v)
+	case reflect.Array, reflect.Slice:
+		return tr.rebuildSlice(path, v)
+	case reflect.Struct:
+		return tr.rebuildStruct(path, v)
+	default:
+		return v, false
+	}
+}
+
+func (tr *transformer) rebuildMap(path Path, v reflect.Value) (reflect.Value, bool) {
+	keys := v.MapKeys()
+	vals := make([]reflect.Value, len(keys))
+	changed := false
+	for i, k := range keys {
+		ev, ch := tr.transform(path.append(k.Interface()), v.MapIndex(k))
+		vals[i] = ev
+		changed = changed || ch
+	}
+	if !changed {
+		return v, false
+	}
+
+	mt := v.Type()
+	if !allAssignable(vals, mt.Elem()) {
+		mt = reflect.MapOf(mt.Key(), _InterfaceType)
+	}
+	nv := reflect.MakeMapWithSize(mt, len(keys))
+	for i, k := range keys {
+		ev, _ := assignable(vals[i], mt.Elem())
+		nv.SetMapIndex(k, ev)
+	}
+	return nv, true
+}
+
+func (tr *transformer) rebuildSlice(path Path, v reflect.Value) (reflect.Value, bool) {
+	if v.Kind() == reflect.Slice && v.IsNil() {
+		return v, false
+	}
+
+	l := v.Len()
+	vals := make([]reflect.Value, l)
+	changed := false
+	for i := 0; i < l; i++ {
+		ev, ch := tr.transform(path.append(i), v.Index(i))
+		vals[i] = ev
+		changed = changed || ch
+	}
+	if !changed {
+		return v, false
+	}
+
+	var nv reflect.Value
+	switch {
+	case !allAssignable(vals, v.Type().Elem()):
+		nv = reflect.MakeSlice(reflect.SliceOf(_InterfaceType), l, l)
+	case v.Kind() == reflect.Array:
+		nv = reflect.New(v.Type()).Elem()
+	default:
+		nv = reflect.MakeSlice(v.Type(), l, l)
+	}
+	for i, ev := range vals {
+		ev, _ = assignable(ev, nv.Type().Elem())
+		nv.Index(i).Set(ev)
+	}
+	return nv, true
+}
+
+func (tr *transformer) rebuildStruct(path Path, v reflect.Value) (reflect.Value, bool) {
+	vt := v.Type()
+	vals := map[int]reflect.Value{}
+	changed, fit := false, true
+	for i := 0; i < vt.NumField(); i++ {
+		sf := vt.Field(i)
+		if sf.PkgPath != "" { // unexported
+			continue
+		}
+		fv, ch := tr.transform(path.append(sf.Name), v.Field(i))
+		vals[i] = fv
+		changed = changed || ch
+		if _, ok := assignable(fv, sf.Type); !ok {
+			fit = false
+		}
+	}
+	if !changed {
+		return v, false
+	}
+
+	if !fit {
+		m := reflect.MakeMapWithSize(reflect.MapOf(_StringType, _InterfaceType), len(vals))
+		for i, fv := range vals {
+			fv, _ = assignable(fv, _InterfaceType)
+			m.SetMapIndex(reflect.ValueOf(vt.Field(i).Name), fv)
+		}
+		return m, true
+	}
+
+	nv := reflect.New(vt).Elem()
+	nv.Set(v)
+	for i, fv := range vals {
+		fv, _ = assignable(fv, vt.Field(i).Type)
+		nv.Field(i).Set(fv)
+	}
+	return nv, true
+}
+
+// assignable returns x as a value assignable to typ, and false if it's not.
+// The invalid value, a nil, is assignable as the zero value to nillable types.
+func assignable(x reflect.Value, typ reflect.Type) (reflect.Value, bool) {
+	if !x.IsValid() {
+		switch typ.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			return reflect.Zero(typ), true
+		default:
+			return x, false
+		}
+	}
+	return x, x.Type().AssignableTo(typ)
+}
+
+func allAssignable(xs []reflect.Value, typ reflect.Type) bool {
+	for _, x := range xs {
+		if _, ok := assignable(x, typ); !ok {
+			return false
+		}
+	}
+	return true
+}
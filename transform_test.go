@@ -0,0 +1,77 @@
+package table
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Transform", func() {
+	Specify("converts integral float64 to int", func() {
+		x := map[string]interface{}{
+			"a": 1.0,
+			"b": []interface{}{2.0, 2.5},
+		}
+		y := New(x).Transform(func(_ Path, v *Table) (interface{}, bool) {
+			f, ok := v.Interface().(float64)
+			if ok && f == float64(int(f)) {
+				return int(f), true
+			}
+			return nil, false
+		})
+		gomega.Expect(y.Interface()).Should(gomega.Equal(map[string]interface{}{
+			"a": 1,
+			"b": []interface{}{2, 2.5},
+		}))
+		gomega.Expect(x["a"]).Should(gomega.Equal(1.0))
+		gomega.Expect(x["b"]).Should(gomega.Equal([]interface{}{2.0, 2.5}))
+	})
+	Specify("keeps typed containers", func() {
+		x := map[string][]string{"a": {" x ", "y "}}
+		y := New(x).Transform(func(_ Path, v *Table) (interface{}, bool) {
+			s, ok := v.Interface().(string)
+			if ok {
+				return strings.TrimSpace(s), true
+			}
+			return nil, false
+		})
+		gomega.Expect(y.Interface()).Should(gomega.Equal(map[string][]string{"a": {"x", "y"}}))
+		gomega.Expect(x["a"][0]).Should(gomega.Equal(" x "))
+	})
+	Specify("falls back to interface{} containers", func() {
+		x := map[string]int{"a": 1}
+		y := New(x).Transform(func(p Path, v *Table) (interface{}, bool) {
+			if p.String() == "a" {
+				return "secret", true
+			}
+			return nil, false
+		})
+		gomega.Expect(y.Interface()).Should(gomega.Equal(map[string]interface{}{"a": "secret"}))
+	})
+	Specify("struct and pointer", func() {
+		type s struct {
+			A string
+			B *int
+		}
+		b := 1
+		x := &s{"a", &b}
+		y := New(x).Transform(func(p Path, v *Table) (interface{}, bool) {
+			if p.String() == "A" {
+				return "z", true
+			}
+			return nil, false
+		})
+		ny := y.Interface().(*s)
+		gomega.Expect(ny).ShouldNot(gomega.BeIdenticalTo(x))
+		gomega.Expect(ny.A).Should(gomega.Equal("z"))
+		gomega.Expect(ny.B).Should(gomega.BeIdenticalTo(&b))
+		gomega.Expect(x.A).Should(gomega.Equal("a"))
+	})
+	Specify("replaces the root", func() {
+		y := New(1).Transform(func(p Path, v *Table) (interface{}, bool) {
+			return nil, true
+		})
+		gomega.Expect(y.Interface()).Should(gomega.BeNil())
+	})
+})
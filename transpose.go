@@ -0,0 +1,165 @@
+package table
+
+import (
+	"reflect"
+)
+
+// TransposeOption configures Columns and Rows.
+type TransposeOption func(*transposeOptions)
+
+type transposeOptions struct {
+	fillZero bool
+}
+
+// TransposeFillZero fills missing cells with the zero value of the column's
+// type instead of nil.
+func TransposeFillZero() TransposeOption {
+	return func(o *transposeOptions) {
+		o.fillZero = true
+	}
+}
+
+// Columns returns the rows of t, e.g. a []map[string]T or a []Struct, as columns,
+// the values of every key in row order.
+//
+// A row missing a key has nil, or the zero value with TransposeFillZero, in
+// the key's column, a nil row misses every key. Unexported struct fields
+// are passed.
+// It returns error if t is not an array or slice of maps or structs.
+func (t *Table) Columns(opts ...TransposeOption) (map[string][]interface{}, error) {
+	o := &transposeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if err := t.check("Table.Columns"); err != nil {
+		return nil, err
+	}
+	if k := indirect(t.getv()).Kind(); k != reflect.Array && k != reflect.Slice {
+		return nil, &ErrUnsupportedKind{"Table.Columns", k}
+	}
+	rows, err := t.Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	cols := map[string][]interface{}{}
+	types := map[string]reflect.Type{}
+	has := map[string][]bool{}
+	for i, row := range rows {
+		if row.IsNil() {
+			continue
+		}
+		m, err := row.Map()
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range m {
+			if !v.getv().CanInterface() {
+				continue
+			}
+			name, err := k.String()
+			if err != nil {
+				return nil, err
+			}
+			col, ok := cols[name]
+			if !ok {
+				col = make([]interface{}, len(rows))
+				cols[name] = col
+				has[name] = make([]bool, len(rows))
+			}
+			col[i] = v.Interface()
+			has[name][i] = true
+			if types[name] == nil {
+				types[name] = cellType(v)
+			}
+		}
+	}
+
+	if o.fillZero {
+		for name, col := range cols {
+			for i, ok := range has[name] {
+				if !ok {
+					col[i] = fillCell(o, types[name])
+				}
+			}
+		}
+	}
+	return cols, nil
+}
+
+// Rows returns the columns of t, e.g. a map[string][]T, as rows,
+// the values of every key at the same index.
+//
+// Columns shorter than the longest one have nil, or the zero value with
+// TransposeFillZero, in the rows beyond them.
+// It returns error if t is not a map or struct of arrays or slices.
+func (t *Table) Rows(opts ...TransposeOption) ([]map[string]interface{}, error) {
+	o := &transposeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	m, err := t.Map()
+	if err != nil {
+		return nil, err
+	}
+
+	cols := map[string][]*Table{}
+	types := map[string]reflect.Type{}
+	n := 0
+	for k, v := range m {
+		if !v.getv().CanInterface() {
+			continue
+		}
+		name, err := k.String()
+		if err != nil {
+			return nil, err
+		}
+		col, err := v.Slice()
+		if err != nil {
+			return nil, err
+		}
+		cols[name] = col
+		if et := indirect(v.getv()); et.IsValid() {
+			types[name] = et.Type().Elem()
+		}
+		if len(col) > n {
+			n = len(col)
+		}
+	}
+
+	rows := make([]map[string]interface{}, n)
+	for i := range rows {
+		row := make(map[string]interface{}, len(cols))
+		for name, col := range cols {
+			if i < len(col) {
+				row[name] = col[i].Interface()
+			} else {
+				row[name] = fillCell(o, types[name])
+			}
+		}
+		rows[i] = row
+	}
+	return rows, nil
+}
+
+// cellType returns the type of the value of the cell v,
+// the dynamic type if it's an interface.
+func cellType(v *Table) reflect.Type {
+	cv := v.getv()
+	if cv.Kind() == reflect.Interface {
+		cv = cv.Elem()
+	}
+	if !cv.IsValid() {
+		return nil
+	}
+	return cv.Type()
+}
+
+func fillCell(o *transposeOptions, typ reflect.Type) interface{} {
+	if !o.fillZero || typ == nil {
+		return nil
+	}
+	return reflect.Zero(typ).Interface()
+}
@@ -0,0 +1,90 @@
+package table
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Transpose", func() {
+	Context("with Columns()", func() {
+		Specify("of maps", func() {
+			x := []map[string]int{{"a": 1, "b": 2}, {"a": 3}}
+			gomega.Expect(New(x).Columns()).Should(gomega.Equal(map[string][]interface{}{
+				"a": {1, 3},
+				"b": {2, nil},
+			}))
+			gomega.Expect(New(x).Columns(TransposeFillZero())).Should(gomega.Equal(map[string][]interface{}{
+				"a": {1, 3},
+				"b": {2, 0},
+			}))
+		})
+		Specify("of decoded JSON", func() {
+			x := []interface{}{
+				map[string]interface{}{"a": "x"},
+				map[string]interface{}{"b": 1.5},
+			}
+			gomega.Expect(New(x).Columns(TransposeFillZero())).Should(gomega.Equal(map[string][]interface{}{
+				"a": {"x", ""},
+				"b": {0.0, 1.5},
+			}))
+		})
+		Specify("with nil rows", func() {
+			x := []interface{}{
+				map[string]interface{}{"a": "x", "b": nil},
+				nil,
+				map[string]interface{}(nil),
+				(*struct{ A int })(nil),
+			}
+			gomega.Expect(New(x).Columns()).Should(gomega.Equal(map[string][]interface{}{
+				"a": {"x", nil, nil, nil},
+				"b": {nil, nil, nil, nil},
+			}))
+			gomega.Expect(New(x).Columns(TransposeFillZero())).Should(gomega.Equal(map[string][]interface{}{
+				"a": {"x", "", "", ""},
+				"b": {nil, nil, nil, nil},
+			}))
+		})
+		Specify("of structs", func() {
+			type s struct {
+				A int
+				B string
+				c int
+			}
+			x := []s{{1, "a", 0}, {2, "b", 0}}
+			gomega.Expect(New(x).Columns()).Should(gomega.Equal(map[string][]interface{}{
+				"A": {1, 2},
+				"B": {"a", "b"},
+			}))
+		})
+		Specify("of other kind", func() {
+			ExpectErr(New(1).Columns()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(New([]int{1}).Columns()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+			ExpectErr(New(struct{ A, B []int }{}).Columns()).Should(gomega.Equal(&ErrUnsupportedKind{"Table.Columns", reflect.Struct}))
+			ExpectErr(New(map[string]int{"a": 1}).Columns()).Should(gomega.Equal(&ErrUnsupportedKind{"Table.Columns", reflect.Map}))
+		})
+	})
+	Context("with Rows()", func() {
+		Specify("of map of slices", func() {
+			x := map[string][]int{"a": {1, 2}, "b": {3}}
+			gomega.Expect(New(x).Rows()).Should(gomega.Equal([]map[string]interface{}{
+				{"a": 1, "b": 3},
+				{"a": 2, "b": nil},
+			}))
+			gomega.Expect(New(x).Rows(TransposeFillZero())).Should(gomega.Equal([]map[string]interface{}{
+				{"a": 1, "b": 3},
+				{"a": 2, "b": 0},
+			}))
+		})
+		Specify("round-trips with Columns()", func() {
+			x := []map[string]interface{}{{"a": 1, "b": "x"}, {"a": 2, "b": "y"}}
+			cols, err := New(x).Columns()
+			gomega.Expect(err).Should(gomega.BeNil())
+			gomega.Expect(New(cols).Rows()).Should(gomega.Equal(x))
+		})
+		Specify("of other kind", func() {
+			ExpectErr(New(map[string]int{"a": 1}).Rows()).To(gomega.BeAssignableToTypeOf((*ErrUnsupportedKind)(nil)))
+		})
+	})
+})
@@ -0,0 +1,156 @@
+package table
+
+import (
+	"reflect"
+)
+
+// WalkAction tells Walk what to do after visiting a node.
+type WalkAction int
+
+const (
+	// Continue goes on walking, into the node's children first.
+	Continue WalkAction = iota
+	// SkipChildren goes on walking, but not into the node's children.
+	SkipChildren
+	// Stop stops walking.
+	Stop
+)
+
+// WalkFunc is the function called by Walk for every node.
+type WalkFunc func(path Path, v *Table) WalkAction
+
+// WalkOption configures Walk.
+type WalkOption func(*walkOptions)
+
+type walkOptions struct {
+	maxDepth  int
+	postOrder bool
+}
+
+// WalkMaxDepth limits Walk to nodes at most depth keys below the root,
+// the root itself is at depth 0.
+func WalkMaxDepth(depth int) WalkOption {
+	return func(o *walkOptions) {
+		o.maxDepth = depth
+	}
+}
+
+// WalkPostOrder visits the children of a node before the node itself.
+// SkipChildren has no effect in post-order.
+func WalkPostOrder() WalkOption {
+	return func(o *walkOptions) {
+		o.postOrder = true
+	}
+}
+
+// Walk visits t and every node below it depth-first, calling f for each node.
+//
+// The children of a map are its values, of an array/slice its elements and
+// of a struct its exported fields. Interfaces and pointers are seen through,
+// a pointer already being walked on the current path is visited but not
+// walked into again, so self-referencing values terminate.
+func (t *Table) Walk(f WalkFunc, opts ...WalkOption) {
+	if t.check("Table.Walk") != nil {
+		return
+	}
+
+	o := walkOptions{maxDepth: -1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	w := &walker{opts: o, f: f, seen: map[walkPtr]bool{}, frozen: t.frozen}
+	w.walk(Path{}, t.getv())
+}
+
+type walkPtr struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+type walker struct {
+	opts walkOptions
+	f    WalkFunc
+	seen map[walkPtr]bool
+
+	// frozen is of the Table walked, the nodes are frozen too.
+	frozen bool
+}
+
+// walk walks v at path, it returns false if walking is stopped.
+func (w *walker) walk(path Path, v reflect.Value) bool {
+	iv, ptrs, cyclic := w.indirect(v)
+	defer func() {
+		for _, p := range ptrs {
+			delete(w.seen, p)
+		}
+	}()
+
+	if !w.opts.postOrder {
+		switch w.f(path, &Table{v: v, frozen: w.frozen}) {
+		case Stop:
+			return false
+		case SkipChildren:
+			return true
+		}
+	}
+
+	if !cyclic && (w.opts.maxDepth < 0 || len(path) < w.opts.maxDepth) {
+		if !w.walkChildren(path, iv) {
+			return false
+		}
+	}
+
+	if w.opts.postOrder {
+		return w.f(path, &Table{v: v, frozen: w.frozen}) != Stop
+	}
+	return true
+}
+
+func (w *walker) walkChildren(path Path, v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Map:
+		for _, k := range sortedMapKeys(v, nil) {
+			if !w.walk(path.append(k.Interface()), v.MapIndex(k)) {
+				return false
+			}
+		}
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if !w.walk(path.append(i), v.Index(i)) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		vt := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			sf := vt.Field(i)
+			if sf.PkgPath != "" { // unexported
+				continue
+			}
+			if !w.walk(path.append(sf.Name), v.Field(i)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// indirect indirects v like indirect, marking the pointers it goes through
+// as seen. It returns the indirected value, the newly marked pointers and
+// whether a pointer has been seen already.
+func (w *walker) indirect(v reflect.Value) (reflect.Value, []walkPtr, bool) {
+	var ptrs []walkPtr
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.Kind() == reflect.Ptr && !v.IsNil() {
+			p := walkPtr{v.Type(), v.Pointer()}
+			if w.seen[p] {
+				return v, ptrs, true
+			}
+			w.seen[p] = true
+			ptrs = append(ptrs, p)
+		}
+		v = v.Elem()
+	}
+	return v, ptrs, false
+}
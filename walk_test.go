@@ -0,0 +1,80 @@
+package table
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Walk", func() {
+	x := map[string]interface{}{
+		"a": []interface{}{1, map[string]int{"b": 2}},
+		"c": struct {
+			D string
+			e string
+		}{"d", "e"},
+	}
+
+	Specify("visits every node", func() {
+		paths := map[string]bool{}
+		New(x).Walk(func(p Path, v *Table) WalkAction {
+			paths[p.String()] = true
+			return Continue
+		})
+		gomega.Expect(paths).Should(gomega.Equal(map[string]bool{
+			"": true, "a": true, "a.0": true, "a.1": true, "a.1.b": true,
+			"c": true, "c.D": true,
+		}))
+	})
+	Specify("in post-order", func() {
+		var paths []string
+		New([]interface{}{[]int{1}}).Walk(func(p Path, v *Table) WalkAction {
+			paths = append(paths, p.String())
+			return Continue
+		}, WalkPostOrder())
+		gomega.Expect(paths).Should(gomega.Equal([]string{"0.0", "0", ""}))
+	})
+	Specify("with SkipChildren", func() {
+		var paths []string
+		New([]interface{}{[]int{1}, 2}).Walk(func(p Path, v *Table) WalkAction {
+			paths = append(paths, p.String())
+			return SkipChildren
+		})
+		gomega.Expect(paths).Should(gomega.Equal([]string{""}))
+	})
+	Specify("with Stop", func() {
+		var paths []string
+		New([]int{1, 2, 3}).Walk(func(p Path, v *Table) WalkAction {
+			paths = append(paths, p.String())
+			if len(p) == 1 && p[0] == 1 {
+				return Stop
+			}
+			return Continue
+		})
+		gomega.Expect(paths).Should(gomega.Equal([]string{"", "0", "1"}))
+	})
+	Specify("with max depth", func() {
+		var paths []string
+		New([]interface{}{[]int{1}}).Walk(func(p Path, v *Table) WalkAction {
+			paths = append(paths, p.String())
+			return Continue
+		}, WalkMaxDepth(1))
+		gomega.Expect(paths).Should(gomega.Equal([]string{"", "0"}))
+	})
+	Specify("self-referencing pointers", func() {
+		type node struct {
+			V    int
+			Next *node
+		}
+		n := &node{V: 1}
+		n.Next = &node{V: 2, Next: n}
+
+		var paths []string
+		New(n).Walk(func(p Path, v *Table) WalkAction {
+			paths = append(paths, p.String())
+			return Continue
+		})
+		gomega.Expect(paths).Should(gomega.Equal([]string{
+			"", "V", "Next", "Next.V", "Next.Next",
+		}))
+	})
+})